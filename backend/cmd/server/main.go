@@ -57,6 +57,13 @@ func main() {
 		}
 		logger.Info("Database initialization complete")
 
+		// Re-tier word difficulty from recorded guesses
+		go func() {
+			if err := db.RecomputeWordDifficulties(); err != nil {
+				logger.Warn("Word difficulty recompute failed", zap.Error(err))
+			}
+		}()
+
 		// Create services and game server
 		userService := user.NewService(logger.Named("user"))
 		gameServer := server.NewGameServer(logger.Named("game"))
@@ -24,7 +24,8 @@ func InitDB() error {
 
 	// Initialize PostgreSQL connection
 	log.Printf("Initializing PostgreSQL connection from DATABASE_URL")
-	DB, err = gorm.Open(postgres.Open(dbURL), &gorm.Config{})
+	// TranslateError turns constraint violations into gorm's sentinel errors
+	DB, err = gorm.Open(postgres.Open(dbURL), &gorm.Config{TranslateError: true})
 	if err != nil {
 		log.Printf("Failed to initialize database: %v", err)
 		return err
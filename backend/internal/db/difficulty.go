@@ -0,0 +1,83 @@
+package db
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+)
+
+const (
+	// Words need this many guessers before their tier is recomputed
+	minDifficultySamples = 5
+	// Guess times are compared against this, so a word solved in a minute or
+	// more counts as slow as it gets
+	slowGuessTime = 60 * time.Second
+)
+
+type wordGuessStats struct {
+	WordID     uint
+	Guessers   int
+	Solvers    int
+	AvgGuessNs float64
+}
+
+// RecomputeWordDifficulties re-tiers words from how often players guess
+// them and how long it takes. Words without enough guesses keep the tier
+// they were seeded with.
+func RecomputeWordDifficulties() error {
+	if DB == nil {
+		return errors.New("database connection not initialized")
+	}
+
+	var stats []wordGuessStats
+	if err := DB.Raw(`
+		SELECT
+			word_id,
+			COUNT(DISTINCT game_id::text || ':' || user_id::text) AS guessers,
+			COUNT(DISTINCT CASE WHEN correct THEN game_id::text || ':' || user_id::text END) AS solvers,
+			COALESCE(AVG(CASE WHEN correct THEN guess_time END), 0) AS avg_guess_ns
+		FROM word_guesses
+		WHERE word_id <> 0 AND deleted_at IS NULL
+		GROUP BY word_id
+		HAVING COUNT(DISTINCT game_id::text || ':' || user_id::text) >= ?
+	`, minDifficultySamples).Scan(&stats).Error; err != nil {
+		log.Printf("Error loading word guess stats: %v", err)
+		return err
+	}
+
+	tx := DB.Begin()
+	for _, s := range stats {
+		tier := difficultyTier(s)
+		if err := tx.Model(&shared.Word{}).Where("id = ?", s.WordID).Update("difficulty", tier).Error; err != nil {
+			tx.Rollback()
+			log.Printf("Error updating difficulty for word %d: %v", s.WordID, err)
+			return err
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		log.Printf("Error committing word difficulties: %v", err)
+		return err
+	}
+
+	log.Printf("Recomputed difficulty for %d words", len(stats))
+	return nil
+}
+
+// difficultyTier scores a word from 0 (everyone gets it instantly) to 1
+// (nobody gets it), weighting the miss rate over guess speed.
+func difficultyTier(s wordGuessStats) string {
+	missRate := 1 - float64(s.Solvers)/float64(s.Guessers)
+	slowness := min(s.AvgGuessNs/float64(slowGuessTime), 1)
+	score := 0.7*missRate + 0.3*slowness
+
+	switch {
+	case score < 0.35:
+		return shared.DifficultyEasy
+	case score < 0.6:
+		return shared.DifficultyMedium
+	default:
+		return shared.DifficultyHard
+	}
+}
@@ -0,0 +1,35 @@
+package db
+
+import "errors"
+
+// SaveTurnDrawing stores the drawing recorded during a turn
+func SaveTurnDrawing(drawing *TurnDrawing) error {
+	if DB == nil {
+		return errors.New("database connection not initialized")
+	}
+	return DB.Create(drawing).Error
+}
+
+// GetTurnDrawings returns every drawing recorded in a game, in play order
+func GetTurnDrawings(gameID string) ([]TurnDrawing, error) {
+	if DB == nil {
+		return nil, errors.New("database connection not initialized")
+	}
+	var drawings []TurnDrawing
+	if err := DB.Where("game_id = ?", gameID).Order("started_at ASC").Find(&drawings).Error; err != nil {
+		return nil, err
+	}
+	return drawings, nil
+}
+
+// GetTurnDrawing returns a single drawing from a game
+func GetTurnDrawing(gameID string, id uint) (*TurnDrawing, error) {
+	if DB == nil {
+		return nil, errors.New("database connection not initialized")
+	}
+	var drawing TurnDrawing
+	if err := DB.Where("game_id = ? AND id = ?", gameID, id).First(&drawing).Error; err != nil {
+		return nil, err
+	}
+	return &drawing, nil
+}
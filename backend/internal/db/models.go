@@ -100,6 +100,24 @@ type WordGuess struct {
 	Game      Game           `gorm:"foreignKey:GameID"`
 }
 
+// TurnDrawing is the recorded drawing from a single turn
+type TurnDrawing struct {
+	ID        uint           `gorm:"primaryKey;autoIncrement"`
+	GameID    string         `gorm:"index;type:uuid"`
+	DrawerID  string         `gorm:"index;type:uuid"`
+	Round     int            `gorm:"not null"`
+	WordID    uint           `gorm:"index"`
+	Word      string         `gorm:"not null"`
+	Category  string         `gorm:"default:''"`
+	StartedAt time.Time      `gorm:"not null"`
+	EndedAt   time.Time      `gorm:"not null"`
+	Timeline  string         `gorm:"type:jsonb"` // Every canvas op with its offset from StartedAt
+	Canvas    string         `gorm:"type:jsonb"` // Canvas ops still visible when the turn ended
+	CreatedAt time.Time      `gorm:"not null"`
+	UpdatedAt time.Time      `gorm:"not null"`
+	DeletedAt gorm.DeletedAt `gorm:"index"`
+}
+
 // MigrateAllModels runs migrations for all models in the system
 func MigrateAllModels() error {
 	return DB.AutoMigrate(
@@ -108,6 +126,7 @@ func MigrateAllModels() error {
 		&GameParticipation{},
 		&LeaderboardEntry{},
 		&WordGuess{},
+		&TurnDrawing{},
 		&shared.Word{},
 	)
 }
@@ -8,6 +8,13 @@ import (
 )
 
 func GetRandomWords(n int) ([]shared.Word, error) {
+	return GetRandomWordsExcluding(n, nil, nil)
+}
+
+// GetRandomWordsExcluding returns up to n random words from the given
+// categories (any category if empty), skipping words in exclude. Words are
+// compared case-insensitively.
+func GetRandomWordsExcluding(n int, categories []string, exclude []string) ([]shared.Word, error) {
 	var words []shared.Word
 	query := DB
 
@@ -20,9 +27,29 @@ func GetRandomWords(n int) ([]shared.Word, error) {
 		query = query.Order("RANDOM()")
 	}
 
+	if len(categories) > 0 {
+		query = query.Where("category IN ?", categories)
+	}
+	if len(exclude) > 0 {
+		lowered := make([]string, len(exclude))
+		for i, w := range exclude {
+			lowered[i] = strings.ToLower(w)
+		}
+		query = query.Where("LOWER(word) NOT IN ?", lowered)
+	}
+
 	// Get random words limited by n
 	if err := query.Limit(n).Find(&words).Error; err != nil {
 		return nil, err
 	}
 	return words, nil
 }
+
+// GetWordCategories returns every category in the word table
+func GetWordCategories() ([]string, error) {
+	var categories []string
+	if err := DB.Model(&shared.Word{}).Distinct("category").Order("category").Pluck("category", &categories).Error; err != nil {
+		return nil, err
+	}
+	return categories, nil
+}
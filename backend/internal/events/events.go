@@ -39,7 +39,10 @@ const (
 	EvtSelectWordTimerTick  PictionaryEventType = "selectWordTimer"      // Server sends remaining word selection time
 	EvtGameCountdownTick    PictionaryEventType = "startGameCountdown"   // Server sends remaining pre-game countdown
 	EvtOpenSelectWordModal  PictionaryEventType = "openSelectWordModal"  // Server tells drawer to select a word
-	EvtGameEnded            PictionaryEventType = "gameEnded"            // Notifies game is over
+	EvtGameEnded            PictionaryEventType = "gameEnded"            // Notifies game is over, with the final summary
+	EvtGameTerminated       PictionaryEventType = "gameTerminated"       // The room was shut down
+	EvtTurnSummary          PictionaryEventType = "turnSummary"          // The word and each player's points after a turn
+	EvtRoundSummary         PictionaryEventType = "roundSummary"         // Standings between rounds
 	EvtErrorNotification    PictionaryEventType = "errorNotification"    // Server sends an error/info message to a client
 	EvtToastNotification    PictionaryEventType = "toastNotification"    // Server sends a toast message to a client
 	// Add more server-to-client message types as needed
@@ -153,10 +156,56 @@ type OpenSelectWordModalPayload struct { // For EvtOpenSelectWordModal (sent to
 	TimeLimit       int           `json:"timeLimit"` // How long they have to pick
 }
 type GameEndedPayload struct { // For EvtGameEnded
-	Winner *shared.Player `json:"winner,omitempty"` // Optional winner
-	Scores map[string]int `json:"scores"`           // PlayerID -> Score
+	HistoryID string         `json:"historyID"`        // The ID this match's drawings are recorded under
+	Winner    *shared.Player `json:"winner,omitempty"` // Set only when nobody shares first place
+	Scores    map[string]int `json:"scores"`           // PlayerID -> Score
+	Ranking   []RankingEntry `json:"ranking"`          // Best first; tied players share a rank
+	Words     []WordSummary  `json:"words"`            // Every word drawn, in play order
 	// Reason   string           `json:"reason,omitempty"` // e.g., "round_limit_reached", "all_players_left"
 }
+type TurnSummaryPayload struct { // For EvtTurnSummary
+	Word      *shared.Word   `json:"word,omitempty"`
+	DrawerID  string         `json:"drawerID"`
+	Deltas    map[string]int `json:"deltas"` // PlayerID -> points earned this turn
+	Standings []Standing     `json:"standings"`
+	Duration  int            `json:"duration"` // Seconds until play moves on
+}
+type RoundSummaryPayload struct { // For EvtRoundSummary
+	Round     int            `json:"round"`
+	Deltas    map[string]int `json:"deltas"` // PlayerID -> points earned this round
+	Standings []Standing     `json:"standings"`
+	Duration  int            `json:"duration"` // Seconds until the next round
+}
+type Standing struct {
+	Rank     int    `json:"rank"` // Ranked like GameEndedPayload.Ranking
+	PlayerID string `json:"playerID"`
+	Username string `json:"username"`
+	Score    int    `json:"score"`
+}
+type RankingEntry struct {
+	Rank   int             `json:"rank"` // 1-based; ties share a rank and the next rank is skipped (1, 1, 3)
+	Player *shared.Player  `json:"player"`
+	Score  int             `json:"score"`
+	Stats  PlayerGameStats `json:"stats"`
+}
+type PlayerGameStats struct {
+	CorrectGuesses int   `json:"correctGuesses"`
+	FastestGuessMs int64 `json:"fastestGuessMs,omitempty"` // Quickest correct guess, from when drawing began
+	GuesserPoints  int   `json:"guesserPoints"`
+	DrawerPoints   int   `json:"drawerPoints"` // Includes the all-guessed bonus
+}
+type WordSummary struct {
+	Round     int           `json:"round"`
+	DrawerID  string        `json:"drawerID"`
+	Word      string        `json:"word"`
+	Category  string        `json:"category,omitempty"`
+	GuessedBy []WordGuesser `json:"guessedBy"` // In the order they guessed
+}
+type WordGuesser struct {
+	PlayerID string `json:"playerID"`
+	Place    int    `json:"place"`
+	TimeMs   int64  `json:"timeMs"` // From when drawing began, not counting pauses
+}
 type ErrorNotificationPayload struct { // For EvtErrorNotification
 	Message string `json:"message"`
 	Code    int    `json:"code,omitempty"` // Optional error code
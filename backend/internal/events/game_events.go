@@ -9,6 +9,9 @@ import (
 type GameEvent struct {
 	Type    string          `json:"type"`
 	Payload json.RawMessage `json:"payload"`
+	// PlayerID is the sender of the event, stamped by the websocket reader.
+	// It is never read from the wire.
+	PlayerID string `json:"-"`
 }
 
 type StartTimerPayload struct {
@@ -51,9 +54,128 @@ type RemovePlayerPayload struct {
 	HostID   string `json:"hostID"`
 }
 
+type UpdateOptionsPayload struct {
+	Options shared.GameOptions `json:"options"`
+}
+
+type SwitchTeamPayload struct {
+	Team int `json:"team"`
+}
+
+type TransferHostPayload struct {
+	PlayerID string `json:"playerID"`
+}
+
+// VoteKickPayload starts a vote to kick PlayerID, or votes in the running
+// one.
+type VoteKickPayload struct {
+	PlayerID string `json:"playerID"`
+	Approve  bool   `json:"approve"`
+}
+
+// DeclineRematchPayload says whether the sender is sitting out the next
+// game.
+type DeclineRematchPayload struct {
+	Decline bool `json:"decline"`
+}
+
 type Cursor struct {
-	X int `json:"x"`
-	Y int `json:"y"`
+	X     float64 `json:"x"`
+	Y     float64 `json:"y"`
+	Color string  `json:"color,omitempty"`
+}
+
+// Point is a canvas coordinate normalized to the canvas size, so both axes
+// run from 0 to 1 regardless of the drawer's screen.
+type Point struct {
+	X float64 `json:"x"`
+	Y float64 `json:"y"`
+}
+
+type StrokePayload struct {
+	ID     string  `json:"id"`
+	Tool   string  `json:"tool"`
+	Points []Point `json:"points"`
+	Color  string  `json:"color"`
+	Size   float64 `json:"size"`
+}
+
+type ShapePayload struct {
+	ID     string  `json:"id"`
+	Shape  string  `json:"shape"`
+	Start  Point   `json:"start"`
+	End    Point   `json:"end"`
+	Color  string  `json:"color"`
+	Size   float64 `json:"size"`
+	Filled bool    `json:"filled"`
+}
+
+type FillPayload struct {
+	ID    string `json:"id"`
+	Point Point  `json:"point"`
+	Color string `json:"color"`
+}
+
+// CanvasOp is one accepted drawing action, in the same type/payload shape
+// the action was broadcast with.
+type CanvasOp struct {
+	Type    string `json:"type"`
+	Payload any    `json:"payload"`
+}
+
+// UnmarshalJSON decodes the payload into the struct matching the op type,
+// so ops read back from storage look the same as ops built in memory.
+func (op *CanvasOp) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Type    string          `json:"type"`
+		Payload json.RawMessage `json:"payload"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	op.Type = raw.Type
+	op.Payload = nil
+
+	var payload any
+	switch raw.Type {
+	case DrawStroke:
+		payload = &StrokePayload{}
+	case DrawShape:
+		payload = &ShapePayload{}
+	case FillArea:
+		payload = &FillPayload{}
+	default:
+		return nil
+	}
+	if err := json.Unmarshal(raw.Payload, payload); err != nil {
+		return err
+	}
+
+	switch p := payload.(type) {
+	case *StrokePayload:
+		op.Payload = *p
+	case *ShapePayload:
+		op.Payload = *p
+	case *FillPayload:
+		op.Payload = *p
+	}
+	return nil
+}
+
+// CanvasHistoryPayload is the current turn's canvas. Seq is the sequence
+// number of the last change it includes; live canvas events carry their own
+// seq, and clients drop any at or below this one since they're already drawn.
+type CanvasHistoryPayload struct {
+	Ops []CanvasOp `json:"ops"`
+	Seq int        `json:"seq"`
+}
+
+// TimelineEntry is a canvas op stamped with when it happened, in
+// milliseconds since the drawing phase of the turn began.
+type TimelineEntry struct {
+	At int64    `json:"at"`
+	Op CanvasOp `json:"op"`
 }
 
 const (
@@ -66,4 +188,19 @@ const (
 	PlayerToggleReady = "playerToggleReady"
 	CursorUpdate      = "cursorUpdate"
 	RemovePlayer      = "removePlayer"
+	DrawStroke        = "drawStroke"
+	DrawShape         = "drawShape"
+	FillArea          = "fillArea"
+	ClearCanvas       = "clearCanvas"
+	CanvasHistory     = "canvasHistory"
+	UndoStroke        = "undoStroke"
+	RedoStroke        = "redoStroke"
+	SwitchTeam        = "switchTeam"
+	UpdateOptions     = "updateOptions"
+	VoteKick          = "voteKick"
+	TransferHost      = "transferHost"
+	PauseGame         = "pauseGame"
+	ResumeGame        = "resumeGame"
+	Rematch           = "rematch"
+	DeclineRematch    = "declineRematch"
 )
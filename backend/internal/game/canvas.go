@@ -0,0 +1,327 @@
+package game
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"regexp"
+	"slices"
+	"time"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+const (
+	maxCanvasOps    = 1000
+	maxTimeline     = 5000
+	maxStrokePoints = 500
+	minBrushSize    = 1
+	maxBrushSize    = 64
+)
+
+// canvasEvents are the drawing events owned by the game loop.
+var canvasEvents = map[string]bool{
+	e.DrawStroke:  true,
+	e.DrawShape:   true,
+	e.FillArea:    true,
+	e.ClearCanvas: true,
+	e.UndoStroke:  true,
+	e.RedoStroke:  true,
+}
+
+var (
+	hexColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+	strokeTools     = map[string]bool{"pencil": true, "eraser": true}
+	shapeKinds      = map[string]bool{"line": true, "rectangle": true, "circle": true, "triangle": true}
+)
+
+// canDraw reports whether playerID is the active drawer of a turn that is
+// in its drawing phase.
+func (g *Game) canDraw(playerID string) bool {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.isActiveDrawer(playerID)
+}
+
+// isActiveDrawer is canDraw for callers that already hold g.Mu.
+func (g *Game) isActiveDrawer(playerID string) bool {
+	return g.Status == InProgress &&
+		!g.Paused &&
+		g.CurrentTurn.Phase == PhaseDrawing &&
+		g.CurrentTurn.WordToGuess != nil &&
+		g.Round.CurrentDrawerID == playerID
+}
+
+func (g *Game) handleStroke(playerID string, stroke e.StrokePayload) {
+	if err := validateStroke(stroke); err != nil {
+		log.Printf("handleStroke: invalid stroke from %s: %v", playerID, err)
+		g.sendError(playerID, err.Error())
+		return
+	}
+	g.applyCanvasOp(playerID, e.CanvasOp{Type: e.DrawStroke, Payload: stroke})
+}
+
+func (g *Game) handleShape(playerID string, shape e.ShapePayload) {
+	if err := validateShape(shape); err != nil {
+		log.Printf("handleShape: invalid shape from %s: %v", playerID, err)
+		g.sendError(playerID, err.Error())
+		return
+	}
+	g.applyCanvasOp(playerID, e.CanvasOp{Type: e.DrawShape, Payload: shape})
+}
+
+func (g *Game) handleFill(playerID string, fill e.FillPayload) {
+	if err := validateFill(fill); err != nil {
+		log.Printf("handleFill: invalid fill from %s: %v", playerID, err)
+		g.sendError(playerID, err.Error())
+		return
+	}
+	g.applyCanvasOp(playerID, e.CanvasOp{Type: e.FillArea, Payload: fill})
+}
+
+// handleClearCanvas, handleUndoStroke and handleRedoStroke rewrite the
+// server's canvas history and tell every client, drawer included, what
+// changed, so no client applies these on its own.
+func (g *Game) handleClearCanvas(playerID string) {
+	g.Mu.Lock()
+	if !g.isActiveDrawer(playerID) {
+		g.Mu.Unlock()
+		log.Printf("handleClearCanvas: rejected clear from non-drawer %s", playerID)
+		return
+	}
+	g.CurrentTurn.clearCanvas()
+	g.CurrentTurn.logTimeline(e.CanvasOp{Type: e.ClearCanvas})
+	seq := g.nextCanvasSeq()
+	g.Mu.Unlock()
+
+	g.broadcastCanvasChange(e.ClearCanvas, nil, seq)
+}
+
+func (g *Game) handleUndoStroke(playerID string) {
+	g.Mu.Lock()
+	if !g.isActiveDrawer(playerID) {
+		g.Mu.Unlock()
+		log.Printf("handleUndoStroke: rejected undo from non-drawer %s", playerID)
+		return
+	}
+	op, ok := g.CurrentTurn.undoCanvasOp()
+	seq := 0
+	if ok {
+		g.CurrentTurn.logTimeline(e.CanvasOp{Type: e.UndoStroke})
+		seq = g.nextCanvasSeq()
+	}
+	g.Mu.Unlock()
+
+	if !ok {
+		return
+	}
+	g.broadcastCanvasChange(e.UndoStroke, op, seq)
+}
+
+func (g *Game) handleRedoStroke(playerID string) {
+	g.Mu.Lock()
+	if !g.isActiveDrawer(playerID) {
+		g.Mu.Unlock()
+		log.Printf("handleRedoStroke: rejected redo from non-drawer %s", playerID)
+		return
+	}
+	op, ok := g.CurrentTurn.redoCanvasOp()
+	seq := 0
+	if ok {
+		g.CurrentTurn.logTimeline(e.CanvasOp{Type: e.RedoStroke})
+		seq = g.nextCanvasSeq()
+	}
+	g.Mu.Unlock()
+
+	if !ok {
+		return
+	}
+	g.broadcastCanvasChange(e.RedoStroke, op, seq)
+}
+
+// applyCanvasOp records op on the current turn's canvas and fans it out.
+// The drawer check and the write happen under one lock so a stroke that
+// races the end of a turn can't leak onto the next drawer's canvas.
+func (g *Game) applyCanvasOp(playerID string, op e.CanvasOp) {
+	g.Mu.Lock()
+	if !g.isActiveDrawer(playerID) {
+		g.Mu.Unlock()
+		log.Printf("applyCanvasOp: rejected %s from non-drawer %s", op.Type, playerID)
+		return
+	}
+	err := g.CurrentTurn.recordCanvasOp(op)
+	seq := 0
+	if err == nil {
+		g.CurrentTurn.logTimeline(op)
+		seq = g.nextCanvasSeq()
+	}
+	g.Mu.Unlock()
+
+	if err != nil {
+		log.Printf("applyCanvasOp: %s from %s not recorded: %v", op.Type, playerID, err)
+		g.sendError(playerID, err.Error())
+		return
+	}
+	g.broadcastCanvasEvent(playerID, op.Type, op.Payload, seq)
+}
+
+// nextCanvasSeq numbers a canvas change. The count runs across turns so a
+// replayed history never hides the next turn's strokes. Callers must hold
+// g.Mu.
+func (g *Game) nextCanvasSeq() int {
+	g.canvasSeq++
+	return g.canvasSeq
+}
+
+// broadcastCanvasEvent fans a validated canvas event out to everyone except
+// the drawer, whose canvas already shows it.
+func (g *Game) broadcastCanvasEvent(drawerID, msgType string, payload any, seq int) {
+	b, err := canvasMessage(msgType, payload, seq)
+	if err != nil {
+		log.Printf("error marshalling %s message: %v", msgType, err)
+		return
+	}
+	g.Messenger.SendToOthers(drawerID, b)
+}
+
+func (g *Game) broadcastCanvasChange(msgType string, payload any, seq int) {
+	b, err := canvasMessage(msgType, payload, seq)
+	if err != nil {
+		log.Printf("error marshalling %s message: %v", msgType, err)
+		return
+	}
+	g.Messenger.BroadcastMessage(b)
+}
+
+// canvasMessage is a canvas event tagged with its sequence number, so a
+// client that also gets a canvasHistory can tell which events it already has.
+func canvasMessage(msgType string, payload any, seq int) ([]byte, error) {
+	return json.Marshal(map[string]any{
+		"type":    msgType,
+		"payload": payload,
+		"seq":     seq,
+	})
+}
+
+// SendCanvasHistory replays the current turn's drawing to a single player,
+// e.g. one who just (re)connected mid-turn.
+func (g *Game) SendCanvasHistory(playerID string) {
+	g.Mu.RLock()
+	ops := slices.Clone(g.CurrentTurn.canvas)
+	seq := g.canvasSeq
+	g.Mu.RUnlock()
+
+	if len(ops) == 0 {
+		return
+	}
+
+	b, err := utils.CreateMessage(e.CanvasHistory, e.CanvasHistoryPayload{Ops: ops, Seq: seq})
+	if err != nil {
+		log.Println("error marshalling canvasHistory message:", err)
+		return
+	}
+	g.Messenger.SendToPlayer(playerID, b)
+}
+
+// recordCanvasOp appends op to the turn's canvas log. Drawing something new
+// discards anything that could have been redone.
+func (t *Turn) recordCanvasOp(op e.CanvasOp) error {
+	if len(t.canvas) >= maxCanvasOps {
+		return errors.New("canvas is full, clear it to keep drawing")
+	}
+	t.canvas = append(t.canvas, op)
+	t.undone = nil
+	return nil
+}
+
+// undoCanvasOp removes the most recent op and returns it.
+func (t *Turn) undoCanvasOp() (e.CanvasOp, bool) {
+	if len(t.canvas) == 0 {
+		return e.CanvasOp{}, false
+	}
+	last := len(t.canvas) - 1
+	op := t.canvas[last]
+	t.canvas = t.canvas[:last]
+	t.undone = append(t.undone, op)
+	return op, true
+}
+
+// redoCanvasOp restores the most recently undone op and returns it.
+func (t *Turn) redoCanvasOp() (e.CanvasOp, bool) {
+	if len(t.undone) == 0 {
+		return e.CanvasOp{}, false
+	}
+	last := len(t.undone) - 1
+	op := t.undone[last]
+	t.undone = t.undone[:last]
+	t.canvas = append(t.canvas, op)
+	return op, true
+}
+
+// clearCanvas wipes the canvas. A clear can't be undone.
+func (t *Turn) clearCanvas() {
+	t.canvas = nil
+	t.undone = nil
+}
+
+// logTimeline stamps op onto the turn's replay timeline. Undo, redo and
+// clear are logged without a payload; replaying them only needs the order.
+func (t *Turn) logTimeline(op e.CanvasOp) {
+	if len(t.timeline) >= maxTimeline {
+		return
+	}
+	t.timeline = append(t.timeline, e.TimelineEntry{
+		At: time.Since(t.drawingStartedAt).Milliseconds(),
+		Op: op,
+	})
+}
+
+func validateStroke(stroke e.StrokePayload) error {
+	if !strokeTools[stroke.Tool] {
+		return errors.New("unknown stroke tool")
+	}
+	if len(stroke.Points) == 0 || len(stroke.Points) > maxStrokePoints {
+		return errors.New("stroke has an invalid number of points")
+	}
+	for _, p := range stroke.Points {
+		if !validPoint(p) {
+			return errors.New("stroke point is outside the canvas")
+		}
+	}
+	return validateBrush(stroke.Color, stroke.Size)
+}
+
+func validateShape(shape e.ShapePayload) error {
+	if !shapeKinds[shape.Shape] {
+		return errors.New("unknown shape")
+	}
+	if !validPoint(shape.Start) || !validPoint(shape.End) {
+		return errors.New("shape is outside the canvas")
+	}
+	return validateBrush(shape.Color, shape.Size)
+}
+
+func validateFill(fill e.FillPayload) error {
+	if !validPoint(fill.Point) {
+		return errors.New("fill point is outside the canvas")
+	}
+	if !hexColorPattern.MatchString(fill.Color) {
+		return errors.New("invalid color")
+	}
+	return nil
+}
+
+func validateBrush(color string, size float64) error {
+	if !hexColorPattern.MatchString(color) {
+		return errors.New("invalid color")
+	}
+	if size < minBrushSize || size > maxBrushSize {
+		return errors.New("brush size out of range")
+	}
+	return nil
+}
+
+func validPoint(p e.Point) bool {
+	return p.X >= 0 && p.X <= 1 && p.Y >= 0 && p.Y <= 1
+}
@@ -0,0 +1,148 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateStroke(t *testing.T) {
+	valid := e.StrokePayload{
+		ID:     "s1",
+		Tool:   "pencil",
+		Points: []e.Point{{X: 0, Y: 0}, {X: 0.5, Y: 0.25}, {X: 1, Y: 1}},
+		Color:  "#1a2B3c",
+		Size:   4,
+	}
+	assert.NoError(t, validateStroke(valid))
+
+	outside := valid
+	outside.Points = []e.Point{{X: 1.01, Y: 0.5}}
+	assert.Error(t, validateStroke(outside))
+
+	noPoints := valid
+	noPoints.Points = nil
+	assert.Error(t, validateStroke(noPoints))
+
+	badColor := valid
+	badColor.Color = "red"
+	assert.Error(t, validateStroke(badColor))
+
+	hugeBrush := valid
+	hugeBrush.Size = maxBrushSize + 1
+	assert.Error(t, validateStroke(hugeBrush))
+
+	badTool := valid
+	badTool.Tool = "spray"
+	assert.Error(t, validateStroke(badTool))
+}
+
+func TestValidateShapeAndFill(t *testing.T) {
+	shape := e.ShapePayload{
+		ID:    "sh1",
+		Shape: "rectangle",
+		Start: e.Point{X: 0.1, Y: 0.1},
+		End:   e.Point{X: 0.9, Y: 0.9},
+		Color: "#000000",
+		Size:  2,
+	}
+	assert.NoError(t, validateShape(shape))
+
+	shape.Shape = "hexagon"
+	assert.Error(t, validateShape(shape))
+
+	fill := e.FillPayload{ID: "f1", Point: e.Point{X: 0.5, Y: 0.5}, Color: "#FFFFFF"}
+	assert.NoError(t, validateFill(fill))
+
+	fill.Point = e.Point{X: -0.1, Y: 0.5}
+	assert.Error(t, validateFill(fill))
+}
+
+func TestRecordCanvasOp(t *testing.T) {
+	turn := NewTurn("drawer")
+	stroke := e.CanvasOp{Type: e.DrawStroke, Payload: e.StrokePayload{ID: "s1"}}
+
+	for range maxCanvasOps {
+		assert.NoError(t, turn.recordCanvasOp(stroke))
+	}
+	assert.Error(t, turn.recordCanvasOp(stroke), "log should be capped")
+	assert.Len(t, turn.canvas, maxCanvasOps)
+
+	turn.clearCanvas()
+	assert.Empty(t, turn.canvas)
+	assert.NoError(t, turn.recordCanvasOp(stroke))
+}
+
+func TestUndoRedoCanvasOp(t *testing.T) {
+	turn := NewTurn("drawer")
+	first := e.CanvasOp{Type: e.DrawStroke, Payload: e.StrokePayload{ID: "s1"}}
+	second := e.CanvasOp{Type: e.FillArea, Payload: e.FillPayload{ID: "f1"}}
+
+	_, ok := turn.undoCanvasOp()
+	assert.False(t, ok, "nothing to undo on an empty canvas")
+
+	assert.NoError(t, turn.recordCanvasOp(first))
+	assert.NoError(t, turn.recordCanvasOp(second))
+
+	op, ok := turn.undoCanvasOp()
+	assert.True(t, ok)
+	assert.Equal(t, second, op)
+	assert.Equal(t, []e.CanvasOp{first}, turn.canvas)
+
+	op, ok = turn.redoCanvasOp()
+	assert.True(t, ok)
+	assert.Equal(t, second, op)
+	assert.Equal(t, []e.CanvasOp{first, second}, turn.canvas)
+
+	// A new op after an undo drops the redo stack.
+	turn.undoCanvasOp()
+	assert.NoError(t, turn.recordCanvasOp(first))
+	_, ok = turn.redoCanvasOp()
+	assert.False(t, ok)
+
+	// Clearing wipes both histories.
+	turn.undoCanvasOp()
+	turn.clearCanvas()
+	_, ok = turn.undoCanvasOp()
+	assert.False(t, ok)
+	_, ok = turn.redoCanvasOp()
+	assert.False(t, ok)
+}
+
+func TestCanvasHistorySeq(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "drawer", "late")
+	messenger := g.Messenger.(*stubMessenger)
+	g.Status = InProgress
+	g.Round.CurrentDrawerID = "drawer"
+	g.CurrentTurn = NewTurn("drawer")
+	g.CurrentTurn.Phase = PhaseDrawing
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	stroke := e.StrokePayload{ID: "s1", Tool: "pencil", Points: []e.Point{{X: 0.5, Y: 0.5}}, Color: "#000000", Size: 4}
+
+	// A stroke lands before the history is sent and another right after
+	g.handleStroke("drawer", stroke)
+	g.SendCanvasHistory("late")
+	g.handleStroke("drawer", stroke)
+
+	var live []int
+	for _, b := range messenger.broadcast {
+		var msg struct {
+			Seq int `json:"seq"`
+		}
+		require.NoError(t, json.Unmarshal(b, &msg))
+		live = append(live, msg.Seq)
+	}
+	assert.Equal(t, []int{1, 2}, live)
+
+	require.Len(t, messenger.direct["late"], 1)
+	var history struct {
+		Payload e.CanvasHistoryPayload `json:"payload"`
+	}
+	require.NoError(t, json.Unmarshal(messenger.direct["late"][0], &history))
+	assert.Len(t, history.Payload.Ops, 1)
+	assert.Equal(t, 1, history.Payload.Seq, "the client drops live stroke 1 and keeps stroke 2")
+}
@@ -0,0 +1,85 @@
+package game
+
+import (
+	"log"
+
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+// maxGuessedChat caps how many guessed-chat lines a turn keeps for players
+// who join the conversation late.
+const maxGuessedChat = 100
+
+// ChatLine is one message in the guessed-players chat.
+type ChatLine struct {
+	PlayerID string `json:"playerID"`
+	Username string `json:"username"`
+	Color    string `json:"color"`
+	Message  string `json:"message"`
+}
+
+// IsChatting reports whether a message from playerID would be chat rather
+// than a guess: the drawer and players who already guessed can only chat.
+func (g *Game) IsChatting(playerID string) bool {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.CurrentTurn.CurrentDrawerID == playerID || g.CurrentTurn.PlayersGuessedCorrectly[playerID]
+}
+
+// guessedChatAudience returns who can read the guessed-players chat: the
+// drawer, everyone who has guessed the word, and spectators. Callers must
+// hold g.Mu.
+func (g *Game) guessedChatAudience() []string {
+	audience := []string{g.CurrentTurn.CurrentDrawerID}
+	for id, guessed := range g.CurrentTurn.PlayersGuessedCorrectly {
+		if guessed {
+			audience = append(audience, id)
+		}
+	}
+	for id := range g.Spectators {
+		audience = append(audience, id)
+	}
+	return audience
+}
+
+// sendGuessedChat posts a message from a player who already guessed the word
+// to the guessed-players chat, so the game stays social without spoiling
+// the answer. Callers must hold g.Mu.
+func (g *Game) sendGuessedChat(playerID, text string) {
+	filtered, ok := g.filterChat(text)
+	if !ok {
+		g.sendError(playerID, "Your message was blocked by the chat filter")
+		return
+	}
+	line := ChatLine{
+		PlayerID: playerID,
+		Username: g.Players[playerID].Username,
+		Color:    g.getPlayerColor(playerID),
+		Message:  filtered,
+	}
+	turn := g.CurrentTurn
+	turn.guessedChat = append(turn.guessedChat, line)
+	if len(turn.guessedChat) > maxGuessedChat {
+		turn.guessedChat = turn.guessedChat[len(turn.guessedChat)-maxGuessedChat:]
+	}
+
+	if b, err := utils.CreateMessage("guessedChat", line); err == nil {
+		g.Messenger.SendToPlayers(g.guessedChatAudience(), b)
+	} else {
+		log.Println("error marshalling guessedChat message:", err)
+	}
+}
+
+// sendGuessedChatHistory catches a player up on this turn's guessed-players
+// chat once they're allowed to read it. Callers must hold g.Mu.
+func (g *Game) sendGuessedChatHistory(playerID string) {
+	if len(g.CurrentTurn.guessedChat) == 0 {
+		return
+	}
+	payload := map[string]any{"messages": g.CurrentTurn.guessedChat}
+	if b, err := utils.CreateMessage("guessedChatHistory", payload); err == nil {
+		g.Messenger.SendToPlayer(playerID, b)
+	} else {
+		log.Println("error marshalling guessedChatHistory message:", err)
+	}
+}
@@ -0,0 +1,35 @@
+package game
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGuessedChatStaysPrivate(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnTimeLimit: 60}, "drawer", "first", "second", "third")
+	messenger := g.Messenger.(*stubMessenger)
+	require.NoError(t, g.AddSpectator(g.NewPlayer("watcher", "watcher", false)))
+	g.CurrentTurn = NewTurn("drawer")
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.CurrentTurn.PlayersGuessedCorrectly["first"] = true
+	g.timers["turnTimer"] = NewTimer(context.Background(), "turnTimer", 60)
+
+	g.handlePlayerGuess("first", "easy one")
+	assert.Empty(t, messenger.broadcast)
+	for _, id := range []string{"drawer", "first", "watcher"} {
+		assert.Len(t, messenger.direct[id], 1, id)
+	}
+	assert.Empty(t, messenger.direct["second"])
+
+	// Guessing catches the player up on what they missed
+	g.handlePlayerGuess("second", "butterfly")
+	require.NotEmpty(t, messenger.direct["second"])
+	assert.Contains(t, string(messenger.direct["second"][0]), "easy one")
+
+	g.handlePlayerGuess("second", "it was a butterfly")
+	assert.Len(t, messenger.direct["first"], 1, "the word stays out of chat")
+}
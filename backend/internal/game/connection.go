@@ -5,15 +5,27 @@ import (
 	"time"
 
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
 )
 
 const (
 	// Grace period for disconnected players to reconnect (in seconds)
 	PlayerReconnectGracePeriod = 30
+
+	// How long a disconnected drawer has to come back before their turn is
+	// skipped, unless the game options say otherwise (in seconds)
+	defaultDrawerReconnectWait = 5
 )
 
 func (g *Game) HandleDisconnect(playerID string) {
 	g.Mu.Lock()
+	if spectator, isSpectator := g.Spectators[playerID]; isSpectator {
+		g.Mu.Unlock()
+		log.Printf("HandleDisconnect: Spectator %s (%s) disconnected", spectator.Username, playerID)
+		g.handleSpectatorDisconnect(spectator)
+		return
+	}
+
 	player, exists := g.Players[playerID]
 	if !exists {
 		g.Mu.Unlock()
@@ -57,6 +69,7 @@ func (g *Game) HandleDisconnect(playerID string) {
 
 	// Update last activity before releasing lock
 	g.lastActivity = time.Now()
+	turn := g.CurrentTurn
 
 	// Release lock before any external calls
 	g.Mu.Unlock()
@@ -64,6 +77,8 @@ func (g *Game) HandleDisconnect(playerID string) {
 	// Broadcast disconnection message
 	g.Messenger.BroadcastMessage([]byte("Player temporarily disconnected: " + playerID))
 	g.BroadcastGameState()
+	g.handleDrawerDisconnect(playerID)
+	g.skipTurnIfNobodyCanGuess(turn)
 
 	// Start timer to remove the player permanently if they don't reconnect
 	time.AfterFunc(time.Duration(PlayerReconnectGracePeriod)*time.Second, func() {
@@ -78,12 +93,15 @@ func (g *Game) HandleDisconnect(playerID string) {
 
 		log.Printf("HandleDisconnect: Grace period expired for player %s, removing permanently", playerID)
 		delete(g.TempDisconnectedPlayers, playerID)
+		g.Round.removeFromOrder(g, playerID)
+		g.endVoteKickOn(playerID, "the player left")
 
 		// Release lock before broadcasting
 		g.Mu.Unlock()
 
 		// Broadcast final removal
 		g.Messenger.BroadcastMessage([]byte("Player permanently removed: " + playerID))
+		g.migrateHostIfNeeded()
 		g.BroadcastGameState()
 	})
 
@@ -127,6 +145,13 @@ func (g *Game) HandleReconnect(playerID string) bool {
 	// Remove from temp storage
 	delete(g.TempDisconnectedPlayers, playerID)
 
+	// Let a drawer who was picking a word carry on where they left off
+	if g.CurrentTurn.CurrentDrawerID == playerID && g.CurrentTurn.IsSelectingWord && !g.Paused {
+		if timer, exists := g.timers["selectWordTimer"]; exists {
+			timer.Resume()
+		}
+	}
+
 	// Release lock before broadcasting
 	g.Mu.Unlock()
 
@@ -136,3 +161,62 @@ func (g *Game) HandleReconnect(playerID string) bool {
 
 	return true
 }
+
+// handleDrawerDisconnect gives a drawer who dropped mid-turn a short window
+// to come back before their turn is skipped, so the room isn't left staring
+// at a frozen canvas.
+func (g *Game) handleDrawerDisconnect(playerID string) {
+	g.Mu.Lock()
+	turn := g.CurrentTurn
+	isDrawer := g.Status == InProgress && turn.CurrentDrawerID == playerID
+	wait := g.Options.DrawerReconnectWait
+	// Hold the word choice until they're back; nobody else can make it
+	if isDrawer && turn.IsSelectingWord {
+		if timer, exists := g.timers["selectWordTimer"]; exists {
+			timer.Pause()
+		}
+	}
+	g.Mu.Unlock()
+	if !isDrawer {
+		return
+	}
+	if wait <= 0 {
+		wait = defaultDrawerReconnectWait
+	}
+
+	time.AfterFunc(time.Duration(wait)*time.Second, func() {
+		g.Mu.RLock()
+		_, stillDisconnected := g.TempDisconnectedPlayers[playerID]
+		g.Mu.RUnlock()
+		if stillDisconnected {
+			g.skipTurn(turn, "the drawer disconnected")
+		}
+	})
+}
+
+// skipTurn ends turn early because its drawer is gone. It does nothing if
+// the turn has already been skipped, ended or moved on.
+func (g *Game) skipTurn(turn *Turn, reason string) {
+	g.Mu.Lock()
+	if g.Status != InProgress || g.CurrentTurn != turn || turn.Phase > PhaseDrawing || turn.skipped {
+		g.Mu.Unlock()
+		return
+	}
+	turn.skipped = true
+	turn.IsSelectingWord = false
+	g.Mu.Unlock()
+
+	log.Printf("skipTurn: skipping %s's turn: %s", turn.CurrentDrawerID, reason)
+	g.CancelTimer("selectWordTimer")
+
+	payload := map[string]any{
+		"playerID": turn.CurrentDrawerID,
+		"reason":   reason,
+	}
+	if b, err := utils.CreateMessage("turnSkipped", payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling turnSkipped message:", err)
+	}
+	g.FlowSignal <- TurnEnded
+}
@@ -0,0 +1,54 @@
+package game
+
+import (
+	"encoding/json"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/db"
+)
+
+// recordTurnDrawing saves the turn's timeline and final canvas so the drawing
+// can be replayed after the game. The write happens off the game loop.
+func (g *Game) recordTurnDrawing(t *Turn) {
+	g.Mu.RLock()
+	if t.WordToGuess == nil || len(t.timeline) == 0 {
+		g.Mu.RUnlock()
+		return
+	}
+	drawing := db.TurnDrawing{
+		GameID:    g.historyID,
+		DrawerID:  t.CurrentDrawerID,
+		Round:     g.Round.Count,
+		WordID:    t.WordToGuess.Id,
+		Word:      t.WordToGuess.Word,
+		Category:  t.WordToGuess.Category,
+		StartedAt: t.drawingStartedAt,
+		EndedAt:   time.Now(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	timeline := slices.Clone(t.timeline)
+	canvas := slices.Clone(t.canvas)
+	g.Mu.RUnlock()
+
+	timelineJSON, err := json.Marshal(timeline)
+	if err != nil {
+		log.Printf("recordTurnDrawing: error marshalling timeline: %v", err)
+		return
+	}
+	canvasJSON, err := json.Marshal(canvas)
+	if err != nil {
+		log.Printf("recordTurnDrawing: error marshalling canvas: %v", err)
+		return
+	}
+	drawing.Timeline = string(timelineJSON)
+	drawing.Canvas = string(canvasJSON)
+
+	go func() {
+		if err := db.SaveTurnDrawing(&drawing); err != nil {
+			log.Printf("recordTurnDrawing: error saving drawing for game %s: %v", drawing.GameID, err)
+		}
+	}()
+}
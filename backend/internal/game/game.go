@@ -6,33 +6,48 @@ import (
 	"sync"
 	"time"
 
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
 	m "github.com/Ajstraight619/pictionary-server/internal/messaging"
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
 )
 
 type Game struct {
-	lifecycle               GameLifecycle             `json:"-"`
-	Mu                      sync.RWMutex              `json:"-"`
-	ID                      string                    `json:"id"`
-	Players                 map[string]*shared.Player `json:"players"`
-	RemovedPlayers          map[string]interface{}    `json:"-"`
-	PlayerOrder             []string                  `json:"playerOrder"`
-	timers                  map[string]*Timer         `json:"-"`
-	Options                 shared.GameOptions        `json:"options"`
-	Status                  Status                    `json:"status"`
-	FlowSignal              chan FlowEvent            `json:"-"`
-	CurrentTurn             *Turn                     `json:"currentTurn"`
-	Round                   *Round                    `json:"round"`
-	Messenger               m.Messenger               `json:"-"`
-	GameEvents              map[string]EventHandler   `json:"-"`
-	UsedWords               []shared.Word             `json:"-"`
-	AvailableColors         []string                  `json:"-"`
-	TempDisconnectedPlayers map[string]*shared.Player `json:"-"`
-	TimerManager            *TimerManager             `json:"-"`
-	WordSelector            *WordSelector             `json:"-"`
-	FlowManager             *FlowManager              `json:"-"`
-	ctx                     context.Context           `json:"-"`
-	lastActivity            time.Time                 `json:"-"`
+	lifecycle               GameLifecycle                 `json:"-"`
+	Mu                      sync.RWMutex                  `json:"-"`
+	ID                      string                        `json:"id"`
+	Code                    string                        `json:"code"`
+	Players                 map[string]*shared.Player     `json:"players"`
+	RemovedPlayers          map[string]interface{}        `json:"-"`
+	PlayerOrder             []string                      `json:"playerOrder"`
+	timers                  map[string]*Timer             `json:"-"`
+	Options                 shared.GameOptions            `json:"options"`
+	Status                  Status                        `json:"status"`
+	Paused                  bool                          `json:"paused"`
+	FlowSignal              chan FlowEvent                `json:"-"`
+	CurrentTurn             *Turn                         `json:"currentTurn"`
+	Round                   *Round                        `json:"round"`
+	Messenger               m.Messenger                   `json:"-"`
+	GameEvents              map[string]EventHandler       `json:"-"`
+	UsedWords               []shared.Word                 `json:"-"`
+	AvailableColors         []string                      `json:"-"`
+	TempDisconnectedPlayers map[string]*shared.Player     `json:"-"`
+	Spectators              map[string]*shared.Player     `json:"-"`
+	TeamScores              map[int]int                   `json:"-"`
+	Scoring                 ScoringPolicy                 `json:"-"`
+	History                 HistoryRecorder               `json:"-"`
+	historyID               string                        `json:"-"`
+	historyQueue            chan historyWrite             `json:"-"`
+	voteKick                *voteKick                     `json:"-"`
+	playerStats             map[string]*e.PlayerGameStats `json:"-"`
+	playedWords             []e.WordSummary               `json:"-"`
+	roundDeltas             map[string]int                `json:"-"`
+	canvasSeq               int                           `json:"-"`
+	rematchDeclined         map[string]bool               `json:"-"`
+	TimerManager            *TimerManager                 `json:"-"`
+	WordSelector            *WordSelector                 `json:"-"`
+	FlowManager             *FlowManager                  `json:"-"`
+	ctx                     context.Context               `json:"-"`
+	lastActivity            time.Time                     `json:"-"`
 }
 
 func NewGame(ctx context.Context, id string, options shared.GameOptions, messenger m.Messenger, lifecycle GameLifecycle) *Game {
@@ -41,18 +56,23 @@ func NewGame(ctx context.Context, id string, options shared.GameOptions, messeng
 		lifecycle:       lifecycle,
 		Players:         make(map[string]*shared.Player),
 		RemovedPlayers:  make(map[string]interface{}), // Set to store players that have been removed from the game
+		Spectators:      make(map[string]*shared.Player),
+		TeamScores:      make(map[int]int),
 		timers:          make(map[string]*Timer),
 		PlayerOrder:     []string{},
-		Options:         options,
+		Options:         normalizeOptions(options),
 		Status:          NotStarted,
 		FlowSignal:      make(chan FlowEvent, 1),
 		Messenger:       messenger,
 		GameEvents:      make(map[string]EventHandler),
 		UsedWords:       []shared.Word{},
 		AvailableColors: slices.Clone(defaultColors),
+		historyID:       id,
+		historyQueue:    make(chan historyWrite, historyQueueSize),
 		ctx:             ctx,
 		lastActivity:    time.Now(),
 	}
+	game.Scoring = scoringPolicyFor(game.Options.Scoring)
 	game.TimerManager = NewTimerManager(game)
 	game.WordSelector = NewWordSelector(game)
 	game.FlowManager = NewFlowManager(game)
@@ -72,3 +92,11 @@ func (g *Game) GetLastActivityInfo() (time.Time, int, Status) {
 	defer g.Mu.RUnlock()
 	return g.lastActivity, len(g.Players), g.Status
 }
+
+// GetHistoryID returns the ID the current match is recorded under. It starts
+// out as the game's ID and changes with every rematch.
+func (g *Game) GetHistoryID() string {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.historyID
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/Ajstraight619/pictionary-server/internal/utils"
 )
 
-type EventHandler func(json.RawMessage)
+type EventHandler func(playerID string, payload json.RawMessage)
 
 func (g *Game) RegisterGameEvent(eventType string, handler EventHandler) {
 	g.Mu.Lock()
@@ -20,7 +20,7 @@ func (g *Game) RegisterGameEvent(eventType string, handler EventHandler) {
 // InitGameEvents registers the default event handlers for a game.
 func (g *Game) InitGameEvents() {
 
-	g.RegisterGameEvent(e.StartTimer, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.StartTimer, func(_ string, payload json.RawMessage) {
 		var pt e.StartTimerPayload
 		if err := json.Unmarshal(payload, &pt); err != nil {
 			log.Println("Error unmarshalling StartTimer payload:", err)
@@ -31,7 +31,7 @@ func (g *Game) InitGameEvents() {
 		}
 	})
 
-	g.RegisterGameEvent(e.StopTimer, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.StopTimer, func(_ string, payload json.RawMessage) {
 		var pt e.StopTimerPayload
 		if err := json.Unmarshal(payload, &pt); err != nil {
 			log.Println("Error unmarshalling StopTimer payload:", err)
@@ -46,16 +46,23 @@ func (g *Game) InitGameEvents() {
 		}
 	})
 
-	g.RegisterGameEvent(e.SelectWord, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.SelectWord, func(playerID string, payload json.RawMessage) {
 		var pt e.SelectWordPayload
 		if err := json.Unmarshal(payload, &pt); err != nil {
 			log.Println("Error unmarshalling SelectWord payload:", err)
 			return
 		}
 
+		// Only the drawer can pick, and only from the words they were offered
+		word, ok := g.selectableWord(playerID, pt.Word)
+		if !ok {
+			log.Printf("SelectWord: rejected %q from player %s", pt.Word.Word, playerID)
+			return
+		}
+
 		g.CancelTimer("selectWordTimer")
 		g.setIsSelectingWord(false)
-		g.setWord(&pt.Word)
+		g.setWord(&word)
 
 		selectWordPayload := map[string]any{
 			"word":            g.CurrentTurn.WordToGuess,
@@ -67,13 +74,17 @@ func (g *Game) InitGameEvents() {
 			log.Println("error marshalling selectedWord message:", err)
 			return
 		}
-		currentDrawer := g.Round.GetCurrentDrawer(g.Players, g.PlayerOrder)
-		g.Messenger.SendToPlayer(currentDrawer.ID, b)
+		g.Mu.RLock()
+		currentDrawer := g.Round.GetCurrentDrawer(g.Players)
+		g.Mu.RUnlock()
+		if currentDrawer != nil {
+			g.Messenger.SendToPlayer(currentDrawer.ID, b)
+		}
 		g.BroadcastGameState()
 		g.FlowSignal <- TurnStarted
 	})
 
-	g.RegisterGameEvent(e.GameState, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.GameState, func(_ string, payload json.RawMessage) {
 		var pt e.GameStatePayload
 
 		if err := json.Unmarshal(payload, &pt); err != nil {
@@ -83,7 +94,7 @@ func (g *Game) InitGameEvents() {
 
 		playerID := pt.PlayerID
 
-		b, err := utils.CreateMessage("gameState", g.GetGameState())
+		b, err := utils.CreateMessage("gameState", g.gameStateFor(playerID))
 		if err != nil {
 			log.Println("error marshalling game state:", err)
 			return
@@ -92,7 +103,7 @@ func (g *Game) InitGameEvents() {
 		g.Messenger.SendToPlayer(playerID, b)
 	})
 
-	g.RegisterGameEvent(e.PlayerGuess, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.PlayerGuess, func(playerID string, payload json.RawMessage) {
 		var pt e.PlayerGuessPayload
 
 		if err := json.Unmarshal(payload, &pt); err != nil {
@@ -100,10 +111,11 @@ func (g *Game) InitGameEvents() {
 			return
 		}
 
-		g.handlePlayerGuess(pt.PlayerID, pt.Guess)
+		// Guess as the connection's player, not whoever the payload claims to be.
+		g.handlePlayerGuess(playerID, pt.Guess)
 	})
 
-	g.RegisterGameEvent(e.PlayerReady, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.PlayerReady, func(_ string, payload json.RawMessage) {
 		var pt e.PlayerReadyPayload
 
 		if err := json.Unmarshal(payload, &pt); err != nil {
@@ -124,7 +136,7 @@ func (g *Game) InitGameEvents() {
 
 	})
 
-	g.RegisterGameEvent(e.PlayerToggleReady, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.PlayerToggleReady, func(_ string, payload json.RawMessage) {
 		var pt e.PlayerToggleReadyPayload
 
 		if err := json.Unmarshal(payload, &pt); err != nil {
@@ -145,7 +157,7 @@ func (g *Game) InitGameEvents() {
 
 	})
 
-	g.RegisterGameEvent(e.CursorUpdate, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.CursorUpdate, func(playerID string, payload json.RawMessage) {
 		var pt e.CursorUpdatePayload
 
 		if err := json.Unmarshal(payload, &pt); err != nil {
@@ -153,13 +165,20 @@ func (g *Game) InitGameEvents() {
 			return
 		}
 
-		// ! Actual implementation: g.Messenger.SendToOthers(pt.PlayerID, payload)
+		// Only the drawer's cursor is shown to the room.
+		if !g.canDraw(playerID) {
+			return
+		}
+		pt.PlayerID = playerID
 
-		// For testing with myself..
-		g.Messenger.SendToPlayer(pt.PlayerID, payload)
+		if b, err := utils.CreateMessage(e.CursorUpdate, pt); err == nil {
+			g.Messenger.SendToOthers(playerID, b)
+		} else {
+			log.Println("error marshalling cursorUpdate message:", err)
+		}
 	})
 
-	g.RegisterGameEvent(e.RemovePlayer, func(payload json.RawMessage) {
+	g.RegisterGameEvent(e.RemovePlayer, func(playerID string, payload json.RawMessage) {
 		var pt e.RemovePlayerPayload
 		if err := json.Unmarshal(payload, &pt); err != nil {
 			log.Println("Error unmarshalling RemovePlayer payload:", err)
@@ -168,7 +187,136 @@ func (g *Game) InitGameEvents() {
 
 		log.Printf("Payload: %+v", pt)
 
-		g.RemovePlayerByHost(pt.PlayerID, pt.HostID)
+		// The host is whoever sent this, not whoever the payload names.
+		g.RemovePlayerByHost(pt.PlayerID, playerID)
+	})
+
+	g.RegisterGameEvent(e.TransferHost, func(playerID string, payload json.RawMessage) {
+		var pt e.TransferHostPayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling TransferHost payload:", err)
+			return
+		}
+
+		if err := g.TransferHost(playerID, pt.PlayerID); err != nil {
+			log.Printf("TransferHost: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.PauseGame, func(playerID string, _ json.RawMessage) {
+		if err := g.PauseGame(playerID); err != nil {
+			log.Printf("PauseGame: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.ResumeGame, func(playerID string, _ json.RawMessage) {
+		if err := g.ResumeGame(playerID); err != nil {
+			log.Printf("ResumeGame: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.Rematch, func(playerID string, _ json.RawMessage) {
+		if err := g.Rematch(playerID); err != nil {
+			log.Printf("Rematch: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.DeclineRematch, func(playerID string, payload json.RawMessage) {
+		var pt e.DeclineRematchPayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling DeclineRematch payload:", err)
+			return
+		}
+
+		if err := g.DeclineRematch(playerID, pt.Decline); err != nil {
+			log.Printf("DeclineRematch: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.VoteKick, func(playerID string, payload json.RawMessage) {
+		var pt e.VoteKickPayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling VoteKick payload:", err)
+			return
+		}
+
+		if err := g.VoteKick(playerID, pt.PlayerID, pt.Approve); err != nil {
+			log.Printf("VoteKick: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.SwitchTeam, func(playerID string, payload json.RawMessage) {
+		var pt e.SwitchTeamPayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling SwitchTeam payload:", err)
+			return
+		}
+
+		if err := g.SwitchTeam(playerID, pt.Team); err != nil {
+			log.Printf("SwitchTeam: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.UpdateOptions, func(playerID string, payload json.RawMessage) {
+		var pt e.UpdateOptionsPayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling UpdateOptions payload:", err)
+			return
+		}
+
+		if err := g.UpdateOptions(playerID, pt.Options); err != nil {
+			log.Printf("UpdateOptions: player %s: %v", playerID, err)
+			g.sendError(playerID, err.Error())
+		}
+	})
+
+	g.RegisterGameEvent(e.DrawStroke, func(playerID string, payload json.RawMessage) {
+		var pt e.StrokePayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling DrawStroke payload:", err)
+			return
+		}
+
+		g.handleStroke(playerID, pt)
+	})
+
+	g.RegisterGameEvent(e.DrawShape, func(playerID string, payload json.RawMessage) {
+		var pt e.ShapePayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling DrawShape payload:", err)
+			return
+		}
+
+		g.handleShape(playerID, pt)
+	})
+
+	g.RegisterGameEvent(e.FillArea, func(playerID string, payload json.RawMessage) {
+		var pt e.FillPayload
+		if err := json.Unmarshal(payload, &pt); err != nil {
+			log.Println("Error unmarshalling FillArea payload:", err)
+			return
+		}
+
+		g.handleFill(playerID, pt)
+	})
+
+	g.RegisterGameEvent(e.ClearCanvas, func(playerID string, _ json.RawMessage) {
+		g.handleClearCanvas(playerID)
+	})
+
+	g.RegisterGameEvent(e.UndoStroke, func(playerID string, _ json.RawMessage) {
+		g.handleUndoStroke(playerID)
+	})
+
+	g.RegisterGameEvent(e.RedoStroke, func(playerID string, _ json.RawMessage) {
+		g.handleRedoStroke(playerID)
 	})
 
 }
@@ -182,8 +330,14 @@ func (g *Game) handleExternalEvent(event e.GameEvent) {
 	g.Mu.RUnlock()
 
 	if exists {
+		// Canvas events run on the game loop so strokes fan out in the order
+		// the drawer made them.
+		if canvasEvents[event.Type] {
+			handler(event.PlayerID, event.Payload)
+			return
+		}
 		log.Printf("Dispatching custom handler for event type: %s", event.Type)
-		go handler(event.Payload)
+		go handler(event.PlayerID, event.Payload)
 		return
 	} else {
 		log.Printf("No handler registered for game event: %s", event.Type)
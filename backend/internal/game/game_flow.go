@@ -52,8 +52,9 @@ func (fm *FlowManager) handleGameEnded() {
 	fm.game.Mu.Lock()
 	fm.game.Status = Finished
 	fm.game.Mu.Unlock()
+	fm.game.recordGameEnd()
+	fm.game.broadcastGameSummary()
 	fm.game.BroadcastGameState()
-
 }
 
 func (fm *FlowManager) handleRoundStarted() {
@@ -77,7 +78,7 @@ func (fm *FlowManager) handleRoundEnded() {
 		fm.game.FlowSignal <- GameEnded
 		return
 	}
-	fm.game.Round.Next(fm.game)
+	fm.game.startRoundSummary()
 }
 
 func (fm *FlowManager) handleTurnStarted() {
@@ -89,6 +90,9 @@ func (fm *FlowManager) handleTurnStarted() {
 	switch turn.Phase {
 	case PhaseWordSelection:
 		if turn.WordToGuess == nil {
+			if fm.game.skipTurnIfNobodyCanGuess(turn) {
+				return
+			}
 			log.Println("No word selected. Initiating word selection...")
 			fm.game.WordSelector.SelectWord()
 			return
@@ -100,7 +104,7 @@ func (fm *FlowManager) handleTurnStarted() {
 		fm.handleTurnStarted()
 	case PhaseDrawing:
 		fm.game.Mu.Lock()
-		drawer := fm.game.Round.GetCurrentDrawer(fm.game.Players, fm.game.PlayerOrder)
+		drawer := fm.game.Round.GetCurrentDrawer(fm.game.Players)
 		fm.game.Mu.Unlock()
 		if drawer == nil {
 			log.Println("No current drawer found; cannot start turn.")
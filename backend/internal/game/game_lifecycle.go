@@ -52,15 +52,16 @@ func (g *Game) cleanup() {
 		}
 	}
 
-	// Notify all players BEFORE we clear state
+	// Notify all players BEFORE we clear state. This is the room shutting
+	// down; the end of a match is announced by the gameEnded summary.
 	message := map[string]interface{}{
-		"type":    "gameEnded",
+		"type":    "gameTerminated",
 		"message": "Game has been terminated",
 	}
-	if b, err := utils.CreateMessage("gameEnded", message); err == nil {
+	if b, err := utils.CreateMessage("gameTerminated", message); err == nil {
 		g.Messenger.BroadcastMessage(b)
 	} else {
-		log.Printf("Error creating gameEnded message: %v", err)
+		log.Printf("Error creating gameTerminated message: %v", err)
 	}
 
 	// Notify lifecycle handler that game is done
@@ -73,6 +74,7 @@ func (g *Game) cleanup() {
 
 func (g *Game) Run() {
 	defer g.cleanup()
+	go g.writeHistory()
 
 	for {
 		select {
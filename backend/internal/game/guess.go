@@ -3,8 +3,8 @@ package game
 import (
 	"fmt"
 	"log"
-	"math"
-	"strings"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
 
 	"github.com/Ajstraight619/pictionary-server/internal/utils"
 )
@@ -27,7 +27,8 @@ func (g *Game) handlePlayerGuess(playerID string, guess string) {
 	log.Printf("WORD: At guess, guess is: %v", guess)
 
 	// Early checks
-	if g.PlayerOrder[g.Round.CurrentDrawerIdx] == playerID {
+	// Spectators aren't in Players, so they can never guess
+	if _, isPlayer := g.Players[playerID]; !isPlayer {
 		return
 	}
 
@@ -39,36 +40,64 @@ func (g *Game) handlePlayerGuess(playerID string, guess string) {
 		return
 	}
 
-	if g.CurrentTurn.PlayersGuessedCorrectly[playerID] {
+	// The drawer and players who already guessed can chat, but not about the
+	// word. Players who guessed talk among themselves until the turn ends.
+	alreadyGuessed := g.CurrentTurn.PlayersGuessedCorrectly[playerID]
+	if g.CurrentTurn.CurrentDrawerID == playerID || alreadyGuessed {
+		if leaksWord(guess, g.CurrentTurn.WordToGuess) {
+			g.sendError(playerID, "You can't give away the word")
+			return
+		}
+		if alreadyGuessed {
+			g.sendGuessedChat(playerID, guess)
+		} else {
+			g.sendChat(playerID, guess)
+		}
 		return
 	}
 
-	normalizedGuess := strings.ToLower(strings.TrimSpace(guess))
-	normalizedWord := strings.ToLower(strings.TrimSpace(g.CurrentTurn.WordToGuess.Word))
+	if g.Paused {
+		g.sendError(playerID, "The game is paused")
+		return
+	}
 
-	if normalizedGuess == normalizedWord {
-		scoreToAdd := calculateScore(g)
+	match := matchGuess(guess, g.CurrentTurn.WordToGuess)
 
-		// Update player's score
+	if match.Exact {
+		// In teammates play other teams can't score, and the word mustn't reach chat
+		if !g.canScoreGuess(playerID) {
+			g.sendError(playerID, "Only the drawer's team can guess this turn")
+			return
+		}
+		g.recordGuess(playerID, guess, true)
+
+		ctx := g.scoreContext()
+		drawerID := g.CurrentTurn.CurrentDrawerID
 		g.CurrentTurn.PlayersGuessedCorrectly[playerID] = true
-		g.Players[playerID].Score += scoreToAdd
+		g.CurrentTurn.guesses = append(g.CurrentTurn.guesses, e.WordGuesser{
+			PlayerID: playerID,
+			Place:    ctx.Place,
+			TimeMs:   g.CurrentTurn.guessLatency().Milliseconds(),
+		})
+		g.awardPoints(playerID, g.Scoring.GuesserPoints(ctx), ScoreReasonGuess, ctx.Place)
+		drawerPoints := g.Scoring.DrawerPoints(ctx)
+		g.awardPoints(drawerID, drawerPoints, ScoreReasonDrawer, 0)
 
 		// Send messages while still holding lock
 		SendGuessMessage(g, playerID, fmt.Sprintf("%s guessed correctly!", g.Players[playerID].Username))
+		g.sendGuessedChatHistory(playerID)
 
-		// Score update
-		scoreUpdatePayload := map[string]interface{}{
-			"playerID": playerID,
-			"score":    g.Players[playerID].Score,
-		}
-		if b, err := utils.CreateMessage("scoreUpdated", scoreUpdatePayload); err == nil {
-			log.Printf("Broadcasting score update for %s: %d points", g.Players[playerID].Username, g.Players[playerID].Score)
-			g.Messenger.BroadcastMessage(b)
+		g.broadcastScore(playerID)
+		if drawerPoints > 0 {
+			g.broadcastScore(drawerID)
 		}
 
 		// Check if all guessed correctly
-		if g.CurrentTurn.allGuessedCorrectly() {
-			addBonusScoreForDrawer(g)
+		if g.CurrentTurn.allGuessedCorrectly(g.eligibleGuessers()) {
+			if bonus := g.Scoring.DrawerBonus(ctx); bonus > 0 {
+				g.awardPoints(drawerID, bonus, ScoreReasonDrawerBonus, 0)
+				g.broadcastScore(drawerID)
+			}
 			unlocked = true
 			g.Mu.Unlock()
 			g.FlowSignal <- TurnEnded
@@ -77,69 +106,67 @@ func (g *Game) handlePlayerGuess(playerID string, guess string) {
 		return
 	}
 
-	// Handle non-correct guess
-	distance := levenshteinDistance(guess, g.CurrentTurn.WordToGuess.Word)
-	if distance <= 2 {
-		log.Printf("Player %s guessed close! (distance: %d)", playerID, distance)
-		SendGuessMessage(g, playerID, fmt.Sprintf("%s guess is close!", g.Players[playerID].Username))
-	} else {
-		log.Printf("Player %s guessed: %s (distance: %d)", playerID, guess, distance)
-		SendGuessMessage(g, playerID, guess)
-	}
-}
+	g.recordGuess(playerID, guess, false)
 
-func calculateScore(g *Game) int {
-
-	turnTimeRemaining := g.GetRemainingTime("turnTimer")
-	// Ensure that we have a valid timer duration.
-	duration := g.timers["turnTimer"].duration
-	if duration == 0 {
-		return 0
+	// Handle non-correct guess. Near-misses would help everyone else, so only
+	// the guesser hears that they're close.
+	if match.Close || leaksWord(guess, g.CurrentTurn.WordToGuess) {
+		log.Printf("Player %s guessed close! (distance: %d)", playerID, match.Distance)
+		sendGuessMessageTo(g, playerID, playerID, fmt.Sprintf("%s is close!", guess))
+		return
 	}
-	// Score is proportional to the remaining time.
-	score := int(100 * (float64(turnTimeRemaining) / float64(duration)))
-	return score
+	log.Printf("Player %s guessed: %s (distance: %d)", playerID, guess, match.Distance)
+	g.sendChat(playerID, guess)
 }
 
-func addBonusScoreForDrawer(g *Game) {
-	drawer := getCurrentDrawer(g)
-	drawer.Score += 100
+// sendChat runs a message through the chat filter and broadcasts it. Callers
+// must hold g.Mu.
+func (g *Game) sendChat(playerID, text string) {
+	filtered, ok := g.filterChat(text)
+	if !ok {
+		g.sendError(playerID, "Your message was blocked by the chat filter")
+		return
+	}
+	SendGuessMessage(g, playerID, filtered)
 }
 
-func levenshteinDistance(s1, s2 string) int {
-	m, n := len(s1), len(s2)
-	dp := make([][]int, m+1)
-
-	// Initialize the DP table
-	for i := range dp {
-		dp[i] = make([]int, n+1)
+// broadcastScore sends a player's total along with the turn's score
+// breakdown so clients can show where points came from. Callers must hold
+// g.Mu.
+func (g *Game) broadcastScore(playerID string) {
+	player, exists := g.Players[playerID]
+	if !exists {
+		return
 	}
-
-	// Base cases
-	for i := 0; i <= m; i++ {
-		dp[i][0] = i
+	scoreUpdatePayload := map[string]interface{}{
+		"playerID":  playerID,
+		"score":     player.Score,
+		"breakdown": g.CurrentTurn.ScoreBreakdown,
 	}
-	for j := 0; j <= n; j++ {
-		dp[0][j] = j
+	if b, err := utils.CreateMessage("scoreUpdated", scoreUpdatePayload); err == nil {
+		log.Printf("Broadcasting score update for %s: %d points", player.Username, player.Score)
+		g.Messenger.BroadcastMessage(b)
 	}
+}
 
-	for i := 1; i <= m; i++ {
-		for j := 1; j <= n; j++ {
-			if s1[i-1] == s2[j-1] {
-				dp[i][j] = dp[i-1][j-1]
-			} else {
-				dp[i][j] = 1 + int(math.Min(math.Min(
-					float64(dp[i-1][j]), // Deletion
-					float64(dp[i][j-1]), // Insertion
-				), float64(dp[i-1][j-1]))) // Substitution
-			}
-		}
+func SendGuessMessage(g *Game, playerID, result string) {
+	if b, err := guessMessage(g, playerID, result); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling guessFeedback message:", err)
 	}
+}
 
-	return dp[m][n]
+// sendGuessMessageTo sends a chat line from playerID to recipientID only.
+func sendGuessMessageTo(g *Game, recipientID, playerID, result string) {
+	if b, err := guessMessage(g, playerID, result); err == nil {
+		g.Messenger.SendToPlayer(recipientID, b)
+	} else {
+		log.Println("error marshalling guessFeedback message:", err)
+	}
 }
 
-func SendGuessMessage(g *Game, playerID, result string) {
+func guessMessage(g *Game, playerID, result string) ([]byte, error) {
 	playerColor := g.getPlayerColor(playerID)
 	log.Printf("Sending guess message for player %s with color %s", playerID, playerColor)
 	payload := map[string]any{
@@ -147,9 +174,5 @@ func SendGuessMessage(g *Game, playerID, result string) {
 		"username": g.Players[playerID].Username,
 		"color":    playerColor,
 	}
-	if b, err := utils.CreateMessage("playerGuess", payload); err == nil {
-		g.Messenger.BroadcastMessage(b)
-	} else {
-		log.Println("error marshalling guessFeedback message:", err)
-	}
+	return utils.CreateMessage("playerGuess", payload)
 }
@@ -0,0 +1,141 @@
+package game
+
+import (
+	"log"
+	"math/rand"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+// maxRevealRatio caps how much of a word hints may give away.
+const maxRevealRatio = 0.7
+
+// hintOrder returns the indices of letters in the order the strategy reveals
+// them. Spaces are never revealed since guessers can already see them.
+func hintOrder(strategy string, letters []rune) []int {
+	switch strategy {
+	case shared.HintsNone:
+		return nil
+	case shared.HintsVowelsFirst:
+		var vowels, consonants []int
+		for i, r := range letters {
+			switch {
+			case r == ' ':
+			case strings.ContainsRune("aeiou", unicode.ToLower(r)):
+				vowels = append(vowels, i)
+			default:
+				consonants = append(consonants, i)
+			}
+		}
+		shuffleIndices(vowels)
+		shuffleIndices(consonants)
+		return append(vowels, consonants...)
+	case shared.HintsFirstLast:
+		var firsts, lasts []int
+		start := -1
+		for i := 0; i <= len(letters); i++ {
+			if i < len(letters) && letters[i] != ' ' {
+				if start < 0 {
+					start = i
+				}
+				continue
+			}
+			if start >= 0 {
+				firsts = append(firsts, start)
+				if i-1 > start {
+					lasts = append(lasts, i-1)
+				}
+				start = -1
+			}
+		}
+		return append(firsts, lasts...)
+	default:
+		order := make([]int, 0, len(letters))
+		for i, r := range letters {
+			if r != ' ' {
+				order = append(order, i)
+			}
+		}
+		shuffleIndices(order)
+		return order
+	}
+}
+
+func shuffleIndices(indices []int) {
+	rand.Shuffle(len(indices), func(i, j int) { indices[i], indices[j] = indices[j], indices[i] })
+}
+
+// hintsAllowed returns how many letters from the reveal order guessers should
+// see with timeRemaining seconds left. Words of three letters or fewer never
+// get hints.
+func hintsAllowed(options shared.GameOptions, totalLetters, orderLen, timeRemaining int) int {
+	turnLimit := options.TurnTimeLimit
+	maxReveal := min(int(float64(totalLetters)*maxRevealRatio), orderLen)
+	if totalLetters <= 3 || maxReveal <= 0 || turnLimit <= 0 {
+		return 0
+	}
+	elapsed := turnLimit - timeRemaining
+
+	switch options.Hints {
+	case shared.HintsNone:
+		return 0
+	case shared.HintsFirstLast:
+		// Space the reveals evenly so the last one isn't saved for the buzzer
+		return min(elapsed*(maxReveal+1)/turnLimit, maxReveal)
+	case shared.HintsFixed:
+		times := options.HintTimes
+		if len(times) == 0 {
+			times = []int{turnLimit / 2, turnLimit / 4}
+		}
+		allowed := 0
+		for _, at := range times {
+			if timeRemaining <= at {
+				allowed++
+			}
+		}
+		return min(allowed, maxReveal)
+	default:
+		return min(elapsed*maxReveal/turnLimit, maxReveal)
+	}
+}
+
+// normalizeHintTimes drops times that can't fire and sorts the rest from
+// first to fire to last.
+func normalizeHintTimes(times []int) []int {
+	result := make([]int, 0, len(times))
+	for _, at := range times {
+		if at > 0 && !slices.Contains(result, at) {
+			result = append(result, at)
+		}
+	}
+	slices.SortFunc(result, func(a, b int) int { return b - a })
+	return result
+}
+
+// sendWordHint tells guessers the word's category and letter counts at the
+// start of a turn, if the game options ask for it. Callers must hold g.Mu.
+func (g *Game) sendWordHint(t *Turn) {
+	if t.WordToGuess == nil || (!g.Options.ShowCategory && !g.Options.ShowWordLength) {
+		return
+	}
+	payload := map[string]any{}
+	if g.Options.ShowCategory {
+		payload["category"] = t.WordToGuess.Category
+	}
+	if g.Options.ShowWordLength {
+		lengths := []int{}
+		for _, word := range strings.Fields(t.WordToGuess.Word) {
+			lengths = append(lengths, len([]rune(word)))
+		}
+		payload["lengths"] = lengths
+	}
+	if b, err := utils.CreateMessage("wordHint", payload); err == nil {
+		g.Messenger.SendToOthers(t.CurrentDrawerID, b)
+	} else {
+		log.Println("error marshalling wordHint message:", err)
+	}
+}
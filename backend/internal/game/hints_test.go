@@ -0,0 +1,44 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHintOrder(t *testing.T) {
+	letters := []rune("ice cream")
+
+	assert.Empty(t, hintOrder(shared.HintsNone, letters))
+	assert.Equal(t, []int{0, 4, 2, 8}, hintOrder(shared.HintsFirstLast, letters))
+
+	order := hintOrder(shared.HintsVowelsFirst, letters)
+	assert.Len(t, order, 8)
+	assert.ElementsMatch(t, []int{0, 2, 6, 7}, order[:4], "vowels come first")
+
+	assert.NotContains(t, hintOrder(shared.HintsLinear, letters), 3, "spaces are never revealed")
+}
+
+func TestHintsAllowed(t *testing.T) {
+	options := shared.GameOptions{TurnTimeLimit: 60}
+
+	// Linear keeps today's pace: up to 70% of the word by the end of the turn
+	assert.Equal(t, 0, hintsAllowed(options, 10, 10, 60))
+	assert.Equal(t, 3, hintsAllowed(options, 10, 10, 30))
+	assert.Equal(t, 7, hintsAllowed(options, 10, 10, 0))
+	assert.Equal(t, 0, hintsAllowed(options, 3, 3, 0), "short words get no hints")
+
+	options.Hints = shared.HintsNone
+	assert.Equal(t, 0, hintsAllowed(options, 10, 10, 0))
+
+	options.Hints = shared.HintsFixed
+	options.HintTimes = []int{40, 10}
+	assert.Equal(t, 0, hintsAllowed(options, 10, 10, 41))
+	assert.Equal(t, 1, hintsAllowed(options, 10, 10, 40))
+	assert.Equal(t, 2, hintsAllowed(options, 10, 10, 5))
+
+	options.Hints = shared.HintsFirstLast
+	assert.Equal(t, 1, hintsAllowed(options, 8, 2, 40))
+	assert.Equal(t, 2, hintsAllowed(options, 8, 2, 20))
+}
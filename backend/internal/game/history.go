@@ -3,6 +3,7 @@ package game
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"log"
 	"time"
 
@@ -33,7 +34,7 @@ func (s *HistoryService) RecordGameStart(gameID string, options shared.GameOptio
 	optionsJSON, err := json.Marshal(options)
 	if err != nil {
 		log.Printf("Error serializing game options: %v", err)
-		return errors.New("error recording game")
+		return fmt.Errorf("error recording game: %w", err)
 	}
 
 	// Create game record
@@ -53,79 +54,112 @@ func (s *HistoryService) RecordGameStart(gameID string, options shared.GameOptio
 	if err := tx.Create(&game).Error; err != nil {
 		tx.Rollback()
 		log.Printf("Error creating game record: %v", err)
-		return errors.New("error recording game")
+		return fmt.Errorf("error recording game: %w", err)
 	}
 
 	// Record player participation
 	for _, player := range players {
-		// Check if player exists in the database
-		var user db.User
-		if err := tx.Where("id = ?", player.ID).First(&user).Error; err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				// Create temporary user record for non-registered players
-				user = db.User{
-					ID:        player.ID,
-					Username:  player.Username,
-					CreatedAt: time.Now(),
-					UpdatedAt: time.Now(),
-				}
-				if err := tx.Create(&user).Error; err != nil {
-					tx.Rollback()
-					log.Printf("Error creating temporary user: %v", err)
-					return errors.New("error recording game")
-				}
-			} else {
-				tx.Rollback()
-				log.Printf("Error checking user: %v", err)
-				return errors.New("error recording game")
-			}
-		}
-
-		// Create participation record
-		participation := db.GameParticipation{
-			UserID:    player.ID,
-			GameID:    gameID,
-			Score:     0, // Initial score is 0
-			IsHost:    player.IsHost,
-			JoinedAt:  time.Now(),
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		}
-
-		if err := tx.Create(&participation).Error; err != nil {
+		if err := recordParticipation(tx, gameID, player); err != nil {
 			tx.Rollback()
-			log.Printf("Error creating participation record: %v", err)
-			return errors.New("error recording game")
+			return fmt.Errorf("error recording game: %w", err)
 		}
 	}
 
 	// Commit transaction
 	if err := tx.Commit().Error; err != nil {
 		log.Printf("Error committing transaction: %v", err)
-		return errors.New("error recording game")
+		return fmt.Errorf("error recording game: %w", err)
 	}
 
 	return nil
 }
 
+// RecordPlayerJoin records a player who took a seat after the game started
+func (s *HistoryService) RecordPlayerJoin(gameID string, player *shared.Player) error {
+	if gameID == "" {
+		return errors.New("game ID is required")
+	}
+
+	tx := s.db.Begin()
+	if err := recordParticipation(tx, gameID, player); err != nil {
+		tx.Rollback()
+		return fmt.Errorf("error recording player join: %w", err)
+	}
+	if err := tx.Commit().Error; err != nil {
+		log.Printf("Error committing transaction: %v", err)
+		return fmt.Errorf("error recording player join: %w", err)
+	}
+
+	return nil
+}
+
+// recordParticipation adds player to the game, creating a user record first
+// if they aren't registered
+func recordParticipation(tx *gorm.DB, gameID string, player *shared.Player) error {
+	// Check if player exists in the database
+	var user db.User
+	if err := tx.Where("id = ?", player.ID).First(&user).Error; err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Printf("Error checking user: %v", err)
+			return err
+		}
+		// Create temporary user record for non-registered players.
+		// Usernames and emails are unique, so guests get placeholders
+		// derived from their player ID.
+		user = db.User{
+			ID:        player.ID,
+			Username:  "guest-" + player.ID,
+			Email:     player.ID + "@guest.invalid",
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+		}
+		if err := tx.Create(&user).Error; err != nil {
+			log.Printf("Error creating temporary user: %v", err)
+			return err
+		}
+	}
+
+	// Create participation record
+	participation := db.GameParticipation{
+		UserID:    player.ID,
+		GameID:    gameID,
+		Score:     0, // Initial score is 0
+		IsHost:    player.IsHost,
+		JoinedAt:  time.Now(),
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	if err := tx.Create(&participation).Error; err != nil {
+		log.Printf("Error creating participation record: %v", err)
+		return err
+	}
+	return nil
+}
+
 // RecordGameEnd records the end of a game with final scores
 func (s *HistoryService) RecordGameEnd(gameID string, winnerID string, playerScores map[string]int) error {
 	if gameID == "" {
 		return errors.New("game ID is required")
 	}
 
+	// A game nobody won has no winner rather than an empty ID
+	var winner interface{}
+	if winnerID != "" {
+		winner = winnerID
+	}
+
 	// Start transaction
 	tx := s.db.Begin()
 
 	// Update game record
 	if err := tx.Model(&db.Game{}).Where("id = ?", gameID).Updates(map[string]interface{}{
 		"ended_at":   time.Now(),
-		"winner_id":  winnerID,
+		"winner_id":  winner,
 		"updated_at": time.Now(),
 	}).Error; err != nil {
 		tx.Rollback()
 		log.Printf("Error updating game record: %v", err)
-		return errors.New("error recording game end")
+		return fmt.Errorf("error recording game end: %w", err)
 	}
 
 	// Update participation records with final scores
@@ -139,7 +173,7 @@ func (s *HistoryService) RecordGameEnd(gameID string, winnerID string, playerSco
 			}).Error; err != nil {
 			tx.Rollback()
 			log.Printf("Error updating participation record: %v", err)
-			return errors.New("error recording game end")
+			return fmt.Errorf("error recording game end: %w", err)
 		}
 
 		// Update user stats
@@ -156,14 +190,14 @@ func (s *HistoryService) RecordGameEnd(gameID string, winnerID string, playerSco
 		`, won, score, score, score, time.Now(), playerID).Error; err != nil {
 			tx.Rollback()
 			log.Printf("Error updating user stats: %v", err)
-			return errors.New("error recording game end")
+			return fmt.Errorf("error recording game end: %w", err)
 		}
 	}
 
 	// Commit transaction
 	if err := tx.Commit().Error; err != nil {
 		log.Printf("Error committing transaction: %v", err)
-		return errors.New("error recording game end")
+		return fmt.Errorf("error recording game end: %w", err)
 	}
 
 	return nil
@@ -184,7 +218,7 @@ func (s *HistoryService) RecordWordGuess(gameID, playerID string, wordID uint, g
 
 	if err := s.db.Create(&wordGuess).Error; err != nil {
 		log.Printf("Error recording word guess: %v", err)
-		return errors.New("error recording word guess")
+		return fmt.Errorf("error recording word guess: %w", err)
 	}
 
 	return nil
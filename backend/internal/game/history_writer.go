@@ -0,0 +1,181 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"gorm.io/gorm"
+)
+
+const (
+	// historyQueueSize is how many writes can wait for the database before
+	// new ones are dropped.
+	historyQueueSize = 256
+	// historyAttempts is how many times a failed write is tried in total.
+	historyAttempts = 4
+	// historyRetryDelay is the wait before the first retry; it doubles after
+	// each failure.
+	historyRetryDelay = time.Second
+)
+
+// HistoryRecorder persists match history. HistoryService is the Postgres
+// implementation; a game without one records nothing.
+type HistoryRecorder interface {
+	RecordGameStart(gameID string, options shared.GameOptions, players []*shared.Player) error
+	RecordPlayerJoin(gameID string, player *shared.Player) error
+	RecordWordGuess(gameID, playerID string, wordID uint, guess string, correct bool, guessTime time.Duration) error
+	RecordGameEnd(gameID string, winnerID string, playerScores map[string]int) error
+}
+
+type historyWrite struct {
+	name  string
+	write func(HistoryRecorder) error
+}
+
+// recordHistory queues a write for writeHistory. It never blocks the caller:
+// if the database has fallen so far behind that the queue is full, the write
+// is dropped.
+func (g *Game) recordHistory(name string, write func(HistoryRecorder) error) {
+	if g.History == nil {
+		return
+	}
+	select {
+	case g.historyQueue <- historyWrite{name: name, write: write}:
+	default:
+		log.Printf("recordHistory: queue full, dropping %s for game %s", name, g.ID)
+	}
+}
+
+// writeHistory runs queued writes one at a time, in order, so a match's
+// guesses never reach the database before the match itself. It runs for the
+// life of the game and flushes what's left when the game shuts down.
+func (g *Game) writeHistory() {
+	for {
+		select {
+		case w := <-g.historyQueue:
+			g.runHistoryWrite(w, historyAttempts)
+		case <-g.ctx.Done():
+			for {
+				select {
+				case w := <-g.historyQueue:
+					g.runHistoryWrite(w, 1)
+				default:
+					return
+				}
+			}
+		}
+	}
+}
+
+func (g *Game) runHistoryWrite(w historyWrite, attempts int) {
+	delay := historyRetryDelay
+	for attempt := 1; ; attempt++ {
+		err := w.write(g.History)
+		if err == nil {
+			return
+		}
+		if permanentHistoryError(err) {
+			log.Printf("writeHistory: dropping %s for game %s: %v", w.name, g.ID, err)
+			return
+		}
+		if attempt >= attempts {
+			log.Printf("writeHistory: giving up on %s for game %s after %d attempts: %v", w.name, g.ID, attempt, err)
+			return
+		}
+		log.Printf("writeHistory: %s for game %s failed (attempt %d), retrying in %v: %v", w.name, g.ID, attempt, delay, err)
+		select {
+		case <-time.After(delay):
+			delay *= 2
+		case <-g.ctx.Done():
+			attempts = attempt + 1
+		}
+	}
+}
+
+// permanentHistoryError reports whether err will come back however often the
+// write is tried, such as a row that breaks a constraint. Only connection
+// trouble and other transient failures are worth a retry.
+func permanentHistoryError(err error) bool {
+	return errors.Is(err, gorm.ErrDuplicatedKey) ||
+		errors.Is(err, gorm.ErrForeignKeyViolated) ||
+		errors.Is(err, gorm.ErrCheckConstraintViolated) ||
+		errors.Is(err, gorm.ErrInvalidField)
+}
+
+// recordGameStart records the match and everyone playing in it once the
+// countdown finishes.
+func (g *Game) recordGameStart() {
+	g.Mu.RLock()
+	gameID := g.historyID
+	options := g.Options
+	players := make([]*shared.Player, 0, len(g.PlayerOrder))
+	for _, id := range g.PlayerOrder {
+		if player, exists := g.Players[id]; exists {
+			snapshot := *player
+			players = append(players, &snapshot)
+		}
+	}
+	g.Mu.RUnlock()
+
+	g.recordHistory("game start", func(h HistoryRecorder) error {
+		return h.RecordGameStart(gameID, options, players)
+	})
+}
+
+// recordPlayerJoin records a player who took a seat mid-match, so their
+// guesses and final score have somewhere to go. Players seated before the
+// match starts are covered by recordGameStart.
+func (g *Game) recordPlayerJoin(player *shared.Player) {
+	g.Mu.RLock()
+	if g.Status != InProgress {
+		g.Mu.RUnlock()
+		return
+	}
+	gameID := g.historyID
+	snapshot := *player
+	g.Mu.RUnlock()
+
+	g.recordHistory("player join", func(h HistoryRecorder) error {
+		return h.RecordPlayerJoin(gameID, &snapshot)
+	})
+}
+
+// recordGuess records a guess at the current word, timed from when the
+// drawing started and leaving out any time the game spent paused. Callers
+// must hold g.Mu.
+func (g *Game) recordGuess(playerID, guess string, correct bool) {
+	turn := g.CurrentTurn
+	if turn.WordToGuess == nil || turn.drawingStartedAt.IsZero() {
+		return
+	}
+	gameID := g.historyID
+	wordID := turn.WordToGuess.Id
+	latency := turn.guessLatency()
+
+	g.recordHistory("word guess", func(h HistoryRecorder) error {
+		return h.RecordWordGuess(gameID, playerID, wordID, guess, correct, latency)
+	})
+}
+
+// recordGameEnd records the final scores and the winner. As in the game
+// summary, a tie for first place has no winner.
+func (g *Game) recordGameEnd() {
+	g.Mu.RLock()
+	gameID := g.historyID
+	players, _ := g.rankedPlayers()
+	scores := make(map[string]int, len(players))
+	for _, player := range players {
+		scores[player.ID] = player.Score
+	}
+	winnerID := ""
+	if winner := outrightWinner(players); winner != nil {
+		winnerID = winner.ID
+	}
+	g.Mu.RUnlock()
+
+	g.recordHistory("game end", func(h HistoryRecorder) error {
+		return h.RecordGameEnd(gameID, winnerID, scores)
+	})
+}
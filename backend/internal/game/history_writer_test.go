@@ -0,0 +1,154 @@
+package game
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+)
+
+// stubHistory records history writes in memory.
+type stubHistory struct {
+	started  []string
+	joined   []string
+	guesses  []bool
+	latency  time.Duration
+	winnerID string
+	scores   map[string]int
+	failures int
+	failWith error
+}
+
+func (h *stubHistory) RecordGameStart(gameID string, _ shared.GameOptions, players []*shared.Player) error {
+	if h.failures > 0 {
+		h.failures--
+		if h.failWith != nil {
+			return h.failWith
+		}
+		return errors.New("database unavailable")
+	}
+	for _, p := range players {
+		h.started = append(h.started, p.ID)
+	}
+	return nil
+}
+
+func (h *stubHistory) RecordPlayerJoin(_ string, player *shared.Player) error {
+	h.joined = append(h.joined, player.ID)
+	return nil
+}
+
+func (h *stubHistory) RecordWordGuess(_, _ string, _ uint, _ string, correct bool, guessTime time.Duration) error {
+	h.guesses = append(h.guesses, correct)
+	h.latency = guessTime
+	return nil
+}
+
+func (h *stubHistory) RecordGameEnd(_ string, winnerID string, playerScores map[string]int) error {
+	h.winnerID = winnerID
+	h.scores = playerScores
+	return nil
+}
+
+// drainHistory runs every queued write once.
+func drainHistory(g *Game) {
+	for {
+		select {
+		case w := <-g.historyQueue:
+			g.runHistoryWrite(w, 1)
+		default:
+			return
+		}
+	}
+}
+
+func TestHistoryRecordsAMatch(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "drawer", "guesser", "other")
+	history := &stubHistory{}
+	g.History = history
+
+	g.recordGameStart()
+	drainHistory(g)
+	assert.Equal(t, []string{"drawer", "guesser", "other"}, history.started)
+
+	g.Status = InProgress
+	g.CurrentTurn = NewTurn("drawer")
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.CurrentTurn.drawingStartedAt = time.Now().Add(-10 * time.Second)
+	g.CurrentTurn.pausedFor = 4 * time.Second
+	g.timers["turnTimer"] = NewTimer(g.ctx, "turnTimer", 60)
+
+	g.handlePlayerGuess("guesser", "moth")
+	g.handlePlayerGuess("guesser", "butterfly")
+	g.handlePlayerGuess("drawer", "nice one")
+	drainHistory(g)
+	assert.Equal(t, []bool{false, true}, history.guesses, "only guesses are recorded, not chat")
+	assert.InDelta(t, 6*time.Second, history.latency, float64(time.Second), "time spent paused doesn't count")
+
+	g.recordGameEnd()
+	drainHistory(g)
+	assert.Equal(t, "guesser", history.winnerID)
+	assert.Len(t, history.scores, 3)
+
+	g.Players["other"].Score = g.Players["guesser"].Score
+	g.recordGameEnd()
+	drainHistory(g)
+	assert.Empty(t, history.winnerID, "a tie for first has no winner")
+	assert.Len(t, history.scores, 3)
+}
+
+func TestHistoryRetriesFailedWrites(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host")
+	history := &stubHistory{failures: 1}
+	g.History = history
+
+	g.recordGameStart()
+	w := <-g.historyQueue
+	g.runHistoryWrite(w, 2)
+	assert.Equal(t, []string{"host"}, history.started)
+}
+
+func TestHistoryDropsPermanentFailures(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host")
+	history := &stubHistory{failures: 1, failWith: fmt.Errorf("error recording game: %w", gorm.ErrForeignKeyViolated)}
+	g.History = history
+
+	g.recordGameStart()
+	w := <-g.historyQueue
+	start := time.Now()
+	g.runHistoryWrite(w, 2)
+	assert.Empty(t, history.started)
+	assert.Less(t, time.Since(start), historyRetryDelay, "constraint violations aren't retried")
+}
+
+func TestHistoryRecordsLateJoiners(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host")
+	history := &stubHistory{}
+	g.History = history
+
+	early := g.NewPlayer("early", "early", false)
+	early.Connected = true
+	require.NoError(t, g.QueuePlayer(early))
+	g.admitQueuedPlayers()
+	drainHistory(g)
+	assert.Empty(t, history.joined, "the game start covers players seated in the lobby")
+
+	g.Status = InProgress
+	late := g.NewPlayer("late", "late", false)
+	late.Connected = true
+	require.NoError(t, g.QueuePlayer(late))
+	g.admitQueuedPlayers()
+	drainHistory(g)
+	assert.Equal(t, []string{"late"}, history.joined)
+}
+
+func TestHistoryWithoutRecorder(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host")
+	g.recordGameStart()
+	require.Empty(t, g.historyQueue)
+}
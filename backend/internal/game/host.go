@@ -0,0 +1,99 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+// TransferHost hands the host role to another connected player.
+func (g *Game) TransferHost(hostID, newHostID string) error {
+	g.Mu.Lock()
+	host, exists := g.Players[hostID]
+	if !exists || !host.IsHost {
+		g.Mu.Unlock()
+		return errors.New("only the host can transfer the host role")
+	}
+	newHost, exists := g.Players[newHostID]
+	if !exists || !newHost.Connected {
+		g.Mu.Unlock()
+		return errors.New("player not found")
+	}
+	if newHostID == hostID {
+		g.Mu.Unlock()
+		return nil
+	}
+	host.IsHost = false
+	newHost.IsHost = true
+	g.broadcastHostChanged(hostID, newHost)
+	g.Mu.Unlock()
+
+	log.Printf("TransferHost: %s handed host to %s in game %s", hostID, newHostID, g.ID)
+	g.BroadcastGameState()
+	return nil
+}
+
+// migrateHostIfNeeded promotes the longest-connected player when the host
+// has left for good, so someone can still start the game and manage the
+// room. A host inside the reconnect grace period keeps the role. Callers
+// broadcast the game state afterwards.
+func (g *Game) migrateHostIfNeeded() {
+	g.Mu.Lock()
+	for _, p := range g.Players {
+		if p.IsHost {
+			g.Mu.Unlock()
+			return
+		}
+	}
+	for _, p := range g.TempDisconnectedPlayers {
+		if p.IsHost {
+			g.Mu.Unlock()
+			return
+		}
+	}
+	newHost := g.longestConnectedPlayer()
+	if newHost == nil {
+		g.Mu.Unlock()
+		return
+	}
+	newHost.IsHost = true
+	g.broadcastHostChanged("", newHost)
+	g.Mu.Unlock()
+
+	log.Printf("migrateHostIfNeeded: promoted %s (%s) to host of game %s", newHost.Username, newHost.ID, g.ID)
+}
+
+// longestConnectedPlayer returns the connected player who joined first, with
+// ties broken by ID so every server picks the same one. Callers must hold
+// g.Mu.
+func (g *Game) longestConnectedPlayer() *shared.Player {
+	var oldest *shared.Player
+	for _, p := range g.Players {
+		if !p.Connected {
+			continue
+		}
+		if oldest == nil || p.JoinedAt.Before(oldest.JoinedAt) ||
+			(p.JoinedAt.Equal(oldest.JoinedAt) && strings.Compare(p.ID, oldest.ID) < 0) {
+			oldest = p
+		}
+	}
+	return oldest
+}
+
+// broadcastHostChanged announces a new host. previousHostID is empty when the
+// old host left. Callers must hold g.Mu.
+func (g *Game) broadcastHostChanged(previousHostID string, newHost *shared.Player) {
+	payload := map[string]any{
+		"hostID":         newHost.ID,
+		"username":       newHost.Username,
+		"previousHostID": previousHostID,
+	}
+	if b, err := utils.CreateMessage("hostChanged", payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling hostChanged message:", err)
+	}
+}
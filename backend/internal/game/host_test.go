@@ -0,0 +1,36 @@
+package game
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostMigration(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host", "early", "late")
+	g.Players["early"].JoinedAt = time.Now().Add(-time.Minute)
+	g.Players["late"].JoinedAt = time.Now()
+
+	// Inside the grace period the host keeps the role
+	g.HandleDisconnect("host")
+	g.migrateHostIfNeeded()
+	assert.False(t, g.Players["early"].IsHost)
+
+	delete(g.TempDisconnectedPlayers, "host")
+	g.migrateHostIfNeeded()
+	assert.True(t, g.Players["early"].IsHost, "the longest-connected player takes over")
+	assert.False(t, g.Players["late"].IsHost)
+}
+
+func TestTransferHost(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host", "a")
+
+	assert.Error(t, g.TransferHost("a", "a"), "only the host can transfer")
+	assert.Error(t, g.TransferHost("host", "nobody"))
+
+	assert.NoError(t, g.TransferHost("host", "a"))
+	assert.True(t, g.Players["a"].IsHost)
+	assert.False(t, g.Players["host"].IsHost)
+}
@@ -0,0 +1,133 @@
+package game
+
+import (
+	"log"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+const (
+	defaultTurnSummaryTime  = 5
+	defaultRoundSummaryTime = 8
+)
+
+// startTurnSummary shows everyone the word and what each player scored
+// before the next drawer picks. A turn that never got a word has nothing to
+// show and moves straight on.
+func (g *Game) startTurnSummary(t *Turn) {
+	g.Mu.Lock()
+	if t.WordToGuess == nil {
+		g.Mu.Unlock()
+		g.endTurnSummary(t)
+		return
+	}
+	t.Phase = PhaseTurnSummary
+	duration := g.Options.TurnSummaryTime
+	if duration <= 0 {
+		duration = defaultTurnSummaryTime
+	}
+	deltas := make(map[string]int)
+	for _, entry := range t.ScoreBreakdown {
+		deltas[entry.PlayerID] += entry.Points
+	}
+	payload := e.TurnSummaryPayload{
+		Word:      t.WordToGuess,
+		DrawerID:  t.CurrentDrawerID,
+		Deltas:    g.withEveryPlayer(deltas),
+		Standings: g.standings(),
+		Duration:  duration,
+	}
+	g.Mu.Unlock()
+
+	g.BroadcastGameState()
+	if b, err := utils.CreateMessage(string(e.EvtTurnSummary), payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling turnSummary message:", err)
+	}
+	g.TimerManager.StartSummaryTimer("turnSummaryTimer", duration, func() {
+		g.endTurnSummary(t)
+	})
+}
+
+// endTurnSummary clears the finished turn away and hands over to the next
+// drawer, or ends the round if everyone has drawn.
+func (g *Game) endTurnSummary(t *Turn) {
+	g.Mu.Lock()
+	t.Phase = PhaseWordSelection
+	t.clearCanvas()
+	g.Mu.Unlock()
+	g.setWord(nil)
+	g.BroadcastGameState()
+	if g.Round.NextDrawer(g) == nil {
+		log.Println("Round is over signalling round ended ")
+		g.FlowSignal <- RoundEnded
+	} else {
+		g.FlowSignal <- TurnStarted
+	}
+}
+
+// startRoundSummary shows the standings and the round's points, then starts
+// the next round.
+func (g *Game) startRoundSummary() {
+	g.Mu.Lock()
+	g.CurrentTurn.Phase = PhaseRoundSummary
+	duration := g.Options.RoundSummaryTime
+	if duration <= 0 {
+		duration = defaultRoundSummaryTime
+	}
+	payload := e.RoundSummaryPayload{
+		Round:     g.Round.Count,
+		Deltas:    g.withEveryPlayer(g.roundDeltas),
+		Standings: g.standings(),
+		Duration:  duration,
+	}
+	turn := g.CurrentTurn
+	g.Mu.Unlock()
+
+	g.BroadcastGameState()
+	if b, err := utils.CreateMessage(string(e.EvtRoundSummary), payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling roundSummary message:", err)
+	}
+	g.TimerManager.StartSummaryTimer("roundSummaryTimer", duration, func() {
+		g.Mu.Lock()
+		turn.Phase = PhaseWordSelection
+		g.Mu.Unlock()
+		g.admitQueuedPlayers()
+		g.Round.Next(g)
+	})
+}
+
+// standings ranks everyone by score for a summary. Callers must hold g.Mu.
+func (g *Game) standings() []e.Standing {
+	players, ranks := g.rankedPlayers()
+	standings := make([]e.Standing, len(players))
+	for i, player := range players {
+		standings[i] = e.Standing{
+			Rank:     ranks[i],
+			PlayerID: player.ID,
+			Username: player.Username,
+			Score:    player.Score,
+		}
+	}
+	return standings
+}
+
+// withEveryPlayer fills in a zero for ranked players missing from deltas, so
+// clients can show "+0" without guessing who played. Callers must hold g.Mu.
+func (g *Game) withEveryPlayer(deltas map[string]int) map[string]int {
+	players, _ := g.rankedPlayers()
+	filled := make(map[string]int, len(players))
+	for id, points := range deltas {
+		filled[id] = points
+	}
+	for _, player := range players {
+		if _, exists := filled[player.ID]; !exists {
+			filled[player.ID] = 0
+		}
+	}
+	return filled
+}
@@ -0,0 +1,113 @@
+package game
+
+import (
+	"encoding/json"
+	"testing"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTurnSummary(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnSummaryTime: 30}, "drawer", "guesser", "other")
+	messenger := g.Messenger.(*stubMessenger)
+	g.Status = InProgress
+	g.PlayerOrder = []string{"drawer", "guesser", "other"}
+	turn := NewTurn("drawer")
+	turn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	turn.canvas = []e.CanvasOp{{Type: e.DrawStroke}}
+	g.CurrentTurn = turn
+	g.Round.CurrentDrawerID = "drawer"
+	g.Round.PlayersDrawn = []string{"drawer"}
+	g.awardPoints("guesser", 80, ScoreReasonGuess, 1)
+	g.awardPoints("drawer", 20, ScoreReasonDrawer, 0)
+
+	g.startTurnSummary(turn)
+	defer g.CancelTimer("turnSummaryTimer")
+	assert.Equal(t, PhaseTurnSummary, turn.Phase)
+	assert.Contains(t, g.timers, "turnSummaryTimer")
+	assert.NotEmpty(t, turn.canvas, "the drawing stays up during the summary")
+
+	var summary e.TurnSummaryPayload
+	for _, b := range messenger.broadcast {
+		var msg struct {
+			Type    string          `json:"type"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		require.NoError(t, json.Unmarshal(b, &msg))
+		if msg.Type == string(e.EvtTurnSummary) {
+			require.NoError(t, json.Unmarshal(msg.Payload, &summary))
+		}
+	}
+	assert.Equal(t, "Butterfly", summary.Word.Word)
+	assert.Equal(t, map[string]int{"guesser": 80, "drawer": 20, "other": 0}, summary.Deltas)
+	require.Len(t, summary.Standings, 3)
+	assert.Equal(t, "guesser", summary.Standings[0].PlayerID)
+	assert.Equal(t, 30, summary.Duration)
+
+	g.endTurnSummary(turn)
+	assert.Empty(t, turn.canvas)
+	assert.Equal(t, TurnStarted, <-g.FlowSignal)
+	assert.Equal(t, "guesser", g.CurrentTurn.CurrentDrawerID)
+}
+
+func TestRoundEndsOnceEveryoneHasDrawn(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnSummaryTime: 30}, "a", "b")
+	g.Status = InProgress
+	g.Round.setInitialDrawer(g)
+
+	for _, want := range []FlowEvent{TurnStarted, RoundEnded} {
+		turn := g.CurrentTurn
+		turn.WordToGuess = &shared.Word{Word: "Butterfly"}
+		turn.End(g)
+		require.Equal(t, PhaseTurnSummary, turn.Phase)
+		g.CancelTimer("turnSummaryTimer")
+		g.endTurnSummary(turn)
+		assert.Equal(t, want, <-g.FlowSignal)
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, g.Round.PlayersDrawn)
+}
+
+func TestTurnEndsOnce(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnSummaryTime: 30}, "a", "b")
+	g.Status = InProgress
+	g.Round.setInitialDrawer(g)
+	turn := g.CurrentTurn
+	turn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.awardPoints("b", 80, ScoreReasonGuess, 1)
+
+	// A timer running out as the last guess lands ends the turn twice
+	turn.End(g)
+	turn.End(g)
+	defer g.CancelTimer("turnSummaryTimer")
+	assert.Len(t, g.playedWords, 1)
+	assert.Equal(t, 80, g.roundDeltas["b"])
+	assert.Equal(t, []string{"a"}, g.Round.PlayersDrawn)
+}
+
+func TestSkippedTurnHasNoSummary(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "drawer", "guesser")
+	g.Status = InProgress
+	turn := NewTurn("drawer")
+	g.CurrentTurn = turn
+	g.Round.PlayersDrawn = []string{"drawer", "guesser"}
+
+	g.startTurnSummary(turn)
+	assert.NotContains(t, g.timers, "turnSummaryTimer")
+	assert.Equal(t, RoundEnded, <-g.FlowSignal)
+}
+
+func TestRoundDeltas(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "a", "b")
+	g.Round.Start(g)
+	<-g.FlowSignal
+
+	for range 2 {
+		turn := NewTurn("a")
+		turn.ScoreBreakdown = []ScoreEntry{{PlayerID: "b", Points: 50, Reason: ScoreReasonGuess}}
+		g.summarizeTurn(turn)
+	}
+	assert.Equal(t, map[string]int{"a": 0, "b": 100}, g.withEveryPlayer(g.roundDeltas))
+}
@@ -0,0 +1,45 @@
+package game
+
+// GameListing is how a game appears in the public lobby browser.
+type GameListing struct {
+	ID            string `json:"id"`
+	Code          string `json:"code"`
+	HostName      string `json:"hostName"`
+	Players       int    `json:"players"`
+	MaxPlayers    int    `json:"maxPlayers"` // 0 means no limit
+	Status        Status `json:"status"`
+	RoundLimit    int    `json:"roundLimit"`
+	TurnTimeLimit int    `json:"turnTimeLimit"`
+	Language      string `json:"language"`
+	IsPublic      bool   `json:"-"`
+}
+
+// Full reports whether the game has no seats left.
+func (l GameListing) Full() bool {
+	return l.MaxPlayers > 0 && l.Players >= l.MaxPlayers
+}
+
+// Listing returns a snapshot of the game for the lobby browser.
+func (g *Game) Listing() GameListing {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+
+	listing := GameListing{
+		ID:            g.ID,
+		Code:          g.Code,
+		Players:       len(g.Players) + g.queuedCount(),
+		MaxPlayers:    g.Options.MaxPlayers,
+		Status:        g.Status,
+		RoundLimit:    g.Options.RoundLimit,
+		TurnTimeLimit: g.Options.TurnTimeLimit,
+		Language:      g.Options.Language,
+		IsPublic:      g.Options.IsPublic,
+	}
+	for _, player := range g.Players {
+		if player.IsHost {
+			listing.HostName = player.Username
+			break
+		}
+	}
+	return listing
+}
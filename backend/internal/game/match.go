@@ -0,0 +1,176 @@
+package game
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"golang.org/x/text/unicode/norm"
+)
+
+// minPluralStem is the shortest word a plural ending is stripped from, so
+// "bu" isn't read as the singular of "bus".
+const minPluralStem = 3
+
+// GuessMatch is how close a guess came to the word.
+type GuessMatch struct {
+	Exact    bool
+	Close    bool
+	Distance int // Smallest edit distance to any accepted form of the word
+}
+
+// matchGuess compares a guess against the word and its accepted alternates.
+// Case, accents, punctuation, spacing and a plural ending don't count against
+// the guess.
+func matchGuess(guess string, word *shared.Word) GuessMatch {
+	if word == nil {
+		return GuessMatch{}
+	}
+	guessKey := compactKey(guess)
+	if guessKey == "" {
+		return GuessMatch{Distance: -1}
+	}
+
+	result := GuessMatch{Distance: -1}
+	for _, form := range acceptedForms(word) {
+		formKey := compactKey(form)
+		if formKey == "" {
+			continue
+		}
+		if sameWordForm(guessKey, formKey) {
+			return GuessMatch{Exact: true}
+		}
+		distance := levenshteinDistance(guessKey, formKey)
+		if result.Distance < 0 || distance < result.Distance {
+			result.Distance = distance
+		}
+		if distance <= closeThreshold(formKey) {
+			result.Close = true
+		}
+	}
+	return result
+}
+
+func acceptedForms(word *shared.Word) []string {
+	return append([]string{word.Word}, word.Alternates...)
+}
+
+// closeThreshold is how many edits still count as a close guess. Longer words
+// get more slack so a typo in "photosynthesis" still earns a hint.
+func closeThreshold(key string) int {
+	switch n := len([]rune(key)); {
+	case n <= 5:
+		return 1
+	case n <= 10:
+		return 2
+	default:
+		return 3
+	}
+}
+
+// foldAccents strips accents from Latin, Greek and Cyrillic letters, so
+// "crème" matches "creme". Marks in other scripts, like the dakuten that
+// turns "ふ" into "ぶ", make a different letter and are kept.
+func foldAccents(s string) string {
+	var b strings.Builder
+	foldable := false
+	for _, r := range norm.NFD.String(s) {
+		if !unicode.Is(unicode.Mn, r) {
+			foldable = unicode.In(r, unicode.Latin, unicode.Greek, unicode.Cyrillic)
+		} else if foldable {
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return norm.NFC.String(b.String())
+}
+
+// normalizeGuessText lowercases text, strips accents, treats punctuation as
+// spacing and collapses runs of whitespace.
+func normalizeGuessText(s string) string {
+	s = strings.Map(func(r rune) rune {
+		if unicode.IsPunct(r) || unicode.IsSymbol(r) {
+			return ' '
+		}
+		return unicode.ToLower(r)
+	}, foldAccents(s))
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// compactKey is the normalized text without spaces, so "ping pong",
+// "ping-pong" and "pingpong" all compare equal.
+func compactKey(s string) string {
+	return strings.ReplaceAll(normalizeGuessText(s), " ", "")
+}
+
+// sameWordForm reports whether a and b are the same word, allowing for a
+// regular English plural ending on either one.
+func sameWordForm(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if len(a) > len(b) {
+		a, b = b, a
+	}
+	if len([]rune(a)) < minPluralStem {
+		return false
+	}
+	switch {
+	case b == a+"s" && !singularEndsInS(b):
+		return true
+	case b == a+"es" && takesEsPlural(a):
+		return true
+	case strings.HasSuffix(a, "y") && b == strings.TrimSuffix(a, "y")+"ies":
+		return true
+	case strings.HasSuffix(a, "fe") && b == strings.TrimSuffix(a, "fe")+"ves":
+		return true
+	case strings.HasSuffix(a, "f") && b == strings.TrimSuffix(a, "f")+"ves":
+		return true
+	}
+	return false
+}
+
+// singularEndsInS reports whether word looks like a singular that already
+// ends in "s", like "glass", "octopus" or "tennis", so dropping the "s"
+// doesn't make a singular of it.
+func singularEndsInS(word string) bool {
+	for _, suffix := range []string{"ss", "us", "is"} {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// takesEsPlural reports whether word forms its plural with "es", as in
+// "boxes" or "potatoes", so "cares" isn't mistaken for a plural of "car".
+func takesEsPlural(word string) bool {
+	for _, suffix := range []string{"s", "x", "z", "ch", "sh", "o"} {
+		if strings.HasSuffix(word, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// levenshteinDistance counts the single-rune edits between s1 and s2.
+func levenshteinDistance(s1, s2 string) int {
+	r1, r2 := []rune(s1), []rune(s2)
+	prev := make([]int, len(r2)+1)
+	curr := make([]int, len(r2)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(r1); i++ {
+		curr[0] = i
+		for j := 1; j <= len(r2); j++ {
+			if r1[i-1] == r2[j-1] {
+				curr[j] = prev[j-1]
+			} else {
+				curr[j] = 1 + min(prev[j], curr[j-1], prev[j-1])
+			}
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(r2)]
+}
@@ -0,0 +1,71 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatchGuessExact(t *testing.T) {
+	cases := []struct {
+		word  shared.Word
+		guess string
+	}{
+		{shared.Word{Word: "Ants"}, "ant"},
+		{shared.Word{Word: "Butterfly"}, "butterflies"},
+		{shared.Word{Word: "ping pong"}, "Ping-Pong"},
+		{shared.Word{Word: "ping pong"}, "  pingpong "},
+		{shared.Word{Word: "Pelé"}, "pele"},
+		{shared.Word{Word: "creme brulee"}, "crème brûlée"},
+		{shared.Word{Word: "Georges St.Pierre"}, "georges st pierre"},
+		{shared.Word{Word: "Hippo", Alternates: []string{"Hippopotamus"}}, "hippopotamuses"},
+		{shared.Word{Word: "Knife"}, "knives"},
+		{shared.Word{Word: "Box"}, "boxes"},
+		{shared.Word{Word: "Potato"}, "potatoes"},
+		{shared.Word{Word: "Paintbrush"}, "paintbrushes"},
+		{shared.Word{Word: "寿司"}, "寿司"},
+	}
+	for _, c := range cases {
+		assert.True(t, matchGuess(c.guess, &c.word).Exact, "%q should match %q", c.guess, c.word.Word)
+	}
+
+	assert.False(t, matchGuess("ant", &shared.Word{Word: "Antelope"}).Exact)
+	assert.False(t, matchGuess("!!!", &shared.Word{Word: "Ants"}).Exact)
+
+	// "es" only pluralizes stems that need it
+	assert.False(t, matchGuess("cares", &shared.Word{Word: "Car"}).Exact)
+	assert.False(t, matchGuess("hates", &shared.Word{Word: "Hat"}).Exact)
+	assert.False(t, matchGuess("pin", &shared.Word{Word: "Pines"}).Exact)
+
+	// Words that are singular with an "s" on the end have no "s"-less form
+	assert.False(t, matchGuess("bu", &shared.Word{Word: "Bus"}).Exact)
+	assert.False(t, matchGuess("glas", &shared.Word{Word: "Glass"}).Exact)
+	assert.False(t, matchGuess("octopu", &shared.Word{Word: "Octopus"}).Exact)
+	assert.True(t, matchGuess("buses", &shared.Word{Word: "Bus"}).Exact)
+
+	// Only Latin, Greek and Cyrillic accents are folded; kana marks matter
+	assert.False(t, matchGuess("ふた", &shared.Word{Word: "ぶた"}).Exact)
+	assert.False(t, matchGuess("か", &shared.Word{Word: "が"}).Exact)
+	assert.True(t, matchGuess("ぶた", &shared.Word{Word: "ぶた"}).Exact)
+}
+
+func TestMatchGuessClose(t *testing.T) {
+	// Distance is counted in runes, not bytes
+	match := matchGuess("żółwie", &shared.Word{Word: "żółw"})
+	assert.False(t, match.Exact)
+	assert.Equal(t, 2, match.Distance)
+
+	// Short words allow one typo, long words allow three
+	assert.True(t, matchGuess("dot", &shared.Word{Word: "Dog"}).Close)
+	assert.False(t, matchGuess("cat", &shared.Word{Word: "Dog"}).Close)
+	assert.True(t, matchGuess("fotosynthesis", &shared.Word{Word: "photosynthesis"}).Close)
+	assert.False(t, matchGuess("synthesis", &shared.Word{Word: "photosynthesis"}).Close)
+}
+
+func TestLevenshteinDistanceRunes(t *testing.T) {
+	assert.Equal(t, 0, levenshteinDistance("", ""))
+	assert.Equal(t, 3, levenshteinDistance("kitten", "sitting"))
+	assert.Equal(t, 1, levenshteinDistance("日本", "日夲"))
+	assert.Equal(t, 2, levenshteinDistance("ab", ""))
+}
@@ -0,0 +1,100 @@
+package game
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+)
+
+// defaultBlockedWords is the base profanity list. Hosts can add to it with
+// GameOptions.BlockedWords.
+var defaultBlockedWords = []string{
+	"fuck", "shit", "bitch", "cunt", "asshole", "dick", "cock", "pussy",
+	"bastard", "slut", "whore", "faggot", "retard", "nigger",
+}
+
+// blockedSuffixes catch common forms of a blocked word, like "fucking".
+var blockedSuffixes = []string{"ing", "er", "ers", "ed", "y"}
+
+// leaksWord reports whether text gives away the word, either by containing
+// it or something close to it, including when it's spelled out with spaces
+// or punctuation ("a-n-t-s").
+func leaksWord(text string, word *shared.Word) bool {
+	if word == nil {
+		return false
+	}
+	tokens := strings.Fields(normalizeGuessText(text))
+	for _, form := range acceptedForms(word) {
+		formKey := compactKey(form)
+		if formKey == "" {
+			continue
+		}
+		// Short words only leak verbatim, or "and" would give away "ant"
+		threshold := 0
+		if len([]rune(formKey)) > 4 {
+			threshold = closeThreshold(formKey)
+		}
+		maxLen := len([]rune(formKey)) + threshold
+		for start := range tokens {
+			span := ""
+			for _, token := range tokens[start:] {
+				span += token
+				if len([]rune(span)) > maxLen+2 {
+					break
+				}
+				if sameWordForm(span, formKey) || levenshteinDistance(span, formKey) <= threshold {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// filterChat applies the game's profanity filter to text. It returns false
+// if the message should be dropped. Callers must hold g.Mu.
+func (g *Game) filterChat(text string) (string, bool) {
+	if g.Options.ChatFilter == shared.ChatFilterOff {
+		return text, true
+	}
+	blocked := append(slices.Clone(defaultBlockedWords), g.Options.BlockedWords...)
+
+	fields := strings.Fields(text)
+	filtered := false
+	for i, field := range fields {
+		if !isBlockedWord(compactKey(field), blocked) {
+			continue
+		}
+		if g.Options.ChatFilter == shared.ChatFilterBlock {
+			return "", false
+		}
+		fields[i] = strings.Repeat("*", len([]rune(field)))
+		filtered = true
+	}
+	if !filtered {
+		return text, true
+	}
+	return strings.Join(fields, " "), true
+}
+
+func isBlockedWord(key string, blocked []string) bool {
+	if key == "" {
+		return false
+	}
+	for _, word := range blocked {
+		wordKey := compactKey(word)
+		if wordKey == "" {
+			continue
+		}
+		if sameWordForm(key, wordKey) {
+			return true
+		}
+		for _, suffix := range blockedSuffixes {
+			if key == wordKey+suffix {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeaksWord(t *testing.T) {
+	ants := &shared.Word{Word: "Ants"}
+	assert.True(t, leaksWord("it's ANTS lol", ants))
+	assert.True(t, leaksWord("a-n-t-s", ants))
+	assert.True(t, leaksWord("ant", ants))
+	assert.False(t, leaksWord("and then", ants), "short words only leak verbatim")
+
+	butterfly := &shared.Word{Word: "Butterfly", Alternates: []string{"Papillon"}}
+	assert.True(t, leaksWord("butterfyl", butterfly))
+	assert.True(t, leaksWord("papillons!", butterfly))
+	assert.False(t, leaksWord("butter", butterfly))
+}
+
+func TestFilterChat(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{BlockedWords: []string{"poop"}}, "a")
+
+	text, ok := g.filterChat("what the fucking poop")
+	assert.True(t, ok)
+	assert.Equal(t, "what the ******* ****", text)
+
+	g.Options.ChatFilter = shared.ChatFilterBlock
+	_, ok = g.filterChat("shit")
+	assert.False(t, ok)
+	text, ok = g.filterChat("Scunthorpe  is fine")
+	assert.True(t, ok)
+	assert.Equal(t, "Scunthorpe  is fine", text)
+
+	g.Options.ChatFilter = shared.ChatFilterOff
+	text, ok = g.filterChat("shit")
+	assert.True(t, ok)
+	assert.Equal(t, "shit", text)
+}
+
+func TestGuessModeration(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnTimeLimit: 60}, "drawer", "guesser")
+	messenger := g.Messenger.(*stubMessenger)
+	g.CurrentTurn = NewTurn("drawer")
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.timers["turnTimer"] = NewTimer(context.Background(), "turnTimer", 60)
+
+	g.handlePlayerGuess("drawer", "it's a butterfly")
+	assert.Empty(t, messenger.broadcast, "the drawer can't post the word")
+	assert.Len(t, messenger.direct["drawer"], 1)
+
+	g.handlePlayerGuess("guesser", "butterfyl")
+	assert.Empty(t, messenger.broadcast, "close guesses stay private")
+	assert.Contains(t, string(messenger.direct["guesser"][0]), "butterfyl is close!")
+
+	g.handlePlayerGuess("drawer", "nice try")
+	assert.Len(t, messenger.broadcast, 1)
+}
+
+func TestWordStaysOutOfSharedState(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "drawer", "guesser")
+	g.Status = InProgress
+	g.Round.CurrentDrawerID = "drawer"
+	g.CurrentTurn = NewTurn("drawer")
+	g.CurrentTurn.SelectableWords = []shared.Word{{Word: "Butterfly"}, {Word: "Rocket"}}
+
+	assert.Empty(t, g.GetGameState().Turn.SelectableWords)
+	assert.Len(t, g.gameStateFor("drawer").Turn.SelectableWords, 2)
+
+	g.CurrentTurn.SelectableWords = nil
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.CurrentTurn.Phase = PhaseDrawing
+	assert.Nil(t, g.GetGameState().Turn.WordToGuess)
+	assert.Nil(t, g.gameStateFor("guesser").Turn.WordToGuess)
+	assert.Equal(t, "Butterfly", g.gameStateFor("drawer").Turn.WordToGuess.Word)
+
+	b, err := json.Marshal(g.GetGameState())
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), "Butterfly")
+
+	// The turn summary reveals the word to everyone
+	g.CurrentTurn.Phase = PhaseTurnSummary
+	assert.Equal(t, "Butterfly", g.GetGameState().Turn.WordToGuess.Word)
+}
@@ -0,0 +1,89 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+)
+
+const (
+	maxCustomWords     = 500
+	maxCustomWordLen   = 32
+	customWordCategory = "Custom"
+)
+
+// normalizeOptions cleans up host-provided options: word lists are trimmed
+// and de-duplicated, oversized lists are cut, hint times are sorted, and the
+// language and team play fall back to their defaults.
+func normalizeOptions(options shared.GameOptions) shared.GameOptions {
+	options.CustomWords = dedupeTrimmed(options.CustomWords, maxCustomWordLen, maxCustomWords)
+	options.Categories = dedupeTrimmed(options.Categories, 0, 0)
+	options.HintTimes = normalizeHintTimes(options.HintTimes)
+	options.BlockedWords = dedupeTrimmed(options.BlockedWords, maxCustomWordLen, maxCustomWords)
+	options.Language = strings.ToLower(strings.TrimSpace(options.Language))
+	if options.Language == "" {
+		options.Language = shared.DefaultLanguage
+	}
+	options.TeamPlay = strings.ToLower(strings.TrimSpace(options.TeamPlay))
+	if options.TeamPlay != shared.TeamPlayRace {
+		options.TeamPlay = shared.TeamPlayTeammates
+	}
+	return options
+}
+
+// dedupeTrimmed trims values and drops blanks, case-insensitive duplicates
+// and values longer than maxLen. maxLen and maxCount are ignored when zero.
+func dedupeTrimmed(values []string, maxLen, maxCount int) []string {
+	seen := make(map[string]bool, len(values))
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		v = strings.Join(strings.Fields(v), " ")
+		key := strings.ToLower(v)
+		if v == "" || seen[key] || (maxLen > 0 && len([]rune(v)) > maxLen) {
+			continue
+		}
+		seen[key] = true
+		result = append(result, v)
+		if maxCount > 0 && len(result) == maxCount {
+			break
+		}
+	}
+	return result
+}
+
+// UpdateOptions replaces the game's options. Only the host can change them,
+// and only before the game starts.
+func (g *Game) UpdateOptions(playerID string, options shared.GameOptions) error {
+	g.Mu.Lock()
+	player, exists := g.Players[playerID]
+	if !exists || !player.IsHost {
+		g.Mu.Unlock()
+		return errors.New("only the host can change the game options")
+	}
+	if g.Status != NotStarted {
+		g.Mu.Unlock()
+		return errors.New("options can only be changed in the lobby")
+	}
+
+	g.Options = normalizeOptions(options)
+	g.Scoring = scoringPolicyFor(g.Options.Scoring)
+
+	// Re-deal teams so turning team mode on, off or resizing it stays balanced
+	for _, id := range g.PlayerOrder {
+		if p, ok := g.Players[id]; ok {
+			p.Team = 0
+		}
+	}
+	for _, id := range g.PlayerOrder {
+		if p, ok := g.Players[id]; ok {
+			g.assignTeam(p)
+		}
+	}
+	g.Mu.Unlock()
+
+	log.Printf("UpdateOptions: host %s updated options for game %s", playerID, g.ID)
+	g.BroadcastGameState()
+	return nil
+}
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+// PauseGame freezes a running game: the turn timers stop where they are and
+// guesses, drawing and word selection are blocked until the host resumes.
+func (g *Game) PauseGame(playerID string) error {
+	return g.setPaused(playerID, true)
+}
+
+// ResumeGame continues a paused game from where it stopped.
+func (g *Game) ResumeGame(playerID string) error {
+	return g.setPaused(playerID, false)
+}
+
+func (g *Game) setPaused(playerID string, paused bool) error {
+	g.Mu.Lock()
+	player, exists := g.Players[playerID]
+	if !exists || !player.IsHost {
+		g.Mu.Unlock()
+		return errors.New("only the host can pause or resume the game")
+	}
+	if g.Status != InProgress {
+		g.Mu.Unlock()
+		return errors.New("the game isn't running")
+	}
+	if g.Paused == paused {
+		g.Mu.Unlock()
+		return nil
+	}
+
+	g.Paused = paused
+	turn := g.CurrentTurn
+	msgType := "gameResumed"
+	if paused {
+		g.TimerManager.PauseTimers()
+		turn.pausedAt = time.Now()
+		msgType = "gamePaused"
+	} else {
+		g.TimerManager.ResumeTimers()
+		if !turn.drawingStartedAt.IsZero() && !turn.pausedAt.IsZero() {
+			turn.pausedFor += time.Since(turn.pausedAt)
+		}
+		turn.pausedAt = time.Time{}
+	}
+	payload := map[string]any{
+		"timeRemaining":       g.GetRemainingTime("turnTimer"),
+		"selectTimeRemaining": g.GetRemainingTime("selectWordTimer"),
+	}
+	g.Mu.Unlock()
+
+	log.Printf("setPaused: host %s set paused=%v for game %s", playerID, paused, g.ID)
+	if b, err := utils.CreateMessage(msgType, payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Printf("error marshalling %s message: %v", msgType, err)
+	}
+	g.BroadcastGameState()
+	return nil
+}
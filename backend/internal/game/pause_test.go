@@ -0,0 +1,61 @@
+package game
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPauseAndResume(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnTimeLimit: 60}, "host", "guesser")
+	assert.Error(t, g.PauseGame("host"), "nothing to pause in the lobby")
+
+	g.Status = InProgress
+	g.CurrentTurn = NewTurn("host")
+	g.CurrentTurn.Phase = PhaseDrawing
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.Round.CurrentDrawerID = "host"
+	timer := NewTimer(context.Background(), "turnTimer", 60)
+	g.timers["turnTimer"] = timer
+	timer.StartCountdown(nil, nil)
+	defer timer.Cancel()
+
+	assert.Error(t, g.PauseGame("guesser"), "only the host can pause")
+	assert.NoError(t, g.PauseGame("host"))
+	assert.True(t, g.GetGameState().Paused)
+	remaining := g.GetRemainingTime("turnTimer")
+	time.Sleep(1500 * time.Millisecond)
+	assert.Equal(t, remaining, g.GetRemainingTime("turnTimer"), "the clock stops while paused")
+	assert.False(t, g.canDraw("host"))
+
+	g.handlePlayerGuess("guesser", "butterfly")
+	assert.False(t, g.CurrentTurn.PlayersGuessedCorrectly["guesser"], "guesses wait for the resume")
+
+	assert.NoError(t, g.ResumeGame("host"))
+	assert.False(t, timer.paused)
+	assert.True(t, g.canDraw("host"))
+
+	g.handlePlayerGuess("guesser", "butterfly")
+	assert.True(t, g.CurrentTurn.PlayersGuessedCorrectly["guesser"])
+}
+
+func TestResumeKeepsWordChoiceForAwayDrawer(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host", "drawer")
+	g.Status = InProgress
+	g.CurrentTurn = NewTurn("drawer")
+	g.CurrentTurn.IsSelectingWord = true
+	g.Round.CurrentDrawerID = "drawer"
+	timer := NewTimer(context.Background(), "selectWordTimer", 10)
+	g.timers["selectWordTimer"] = timer
+
+	g.HandleDisconnect("drawer")
+	assert.NoError(t, g.PauseGame("host"))
+	assert.NoError(t, g.ResumeGame("host"))
+	assert.True(t, timer.paused, "the word choice waits for the drawer")
+
+	assert.True(t, g.HandleReconnect("drawer"))
+	assert.False(t, timer.paused)
+}
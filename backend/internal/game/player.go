@@ -5,8 +5,6 @@ import (
 	"log"
 	"time"
 
-	"slices"
-
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
 	"github.com/Ajstraight619/pictionary-server/internal/utils"
 )
@@ -43,16 +41,22 @@ func (g *Game) AddPlayer(player *shared.Player) {
 	if _, exists := g.Players[player.ID]; exists {
 		return
 	}
+	g.assignColor(player)
+	g.assignTeam(player)
+	g.Players[player.ID] = player
+	g.PlayerOrder = append(g.PlayerOrder, player.ID)
+	log.Printf("AddPlayer: added player %s with color %s; current PlayerOrder: %+v", player.ID, player.Color, g.PlayerOrder)
+
+}
+
+// assignColor gives player the next free color. Callers must hold g.Mu.
+func (g *Game) assignColor(player *shared.Player) {
 	if len(g.AvailableColors) > 0 {
 		player.Color = g.AvailableColors[0]
 		g.AvailableColors = g.AvailableColors[1:]
 	} else {
 		player.Color = "#FFFFFF"
 	}
-	g.Players[player.ID] = player
-	g.PlayerOrder = append(g.PlayerOrder, player.ID)
-	log.Printf("AddPlayer: added player %s with color %s; current PlayerOrder: %+v", player.ID, player.Color, g.PlayerOrder)
-
 }
 
 func (g *Game) RemovePlayer(playerID string) {
@@ -69,8 +73,10 @@ func (g *Game) RemovePlayer(playerID string) {
 		var msgType string
 		if _, wasRemoved := g.RemovedPlayers[playerID]; wasRemoved {
 			msgType = "playerRemoved"
+			g.endVoteKickOn(playerID, "the player was removed")
 		} else {
 			msgType = "playerLeft"
+			g.endVoteKickOn(playerID, "the player left")
 		}
 
 		log.Printf("RemovePlayer: removing player %s with msgType %s", playerID, msgType)
@@ -84,12 +90,7 @@ func (g *Game) RemovePlayer(playerID string) {
 		g.Messenger.BroadcastMessage(msg)
 	}
 
-	for i, id := range g.PlayerOrder {
-		if id == playerID {
-			g.PlayerOrder = slices.Delete(g.PlayerOrder, i, i+1)
-			break
-		}
-	}
+	g.Round.removeFromOrder(g, playerID)
 }
 
 func (g *Game) RemovePlayerByHost(playerID string, hostID string) error {
@@ -103,38 +104,66 @@ func (g *Game) RemovePlayerByHost(playerID string, hostID string) error {
 	}
 	g.Mu.RUnlock()
 
+	log.Printf("RemovePlayerByHost: host %s is removing player %s", hostID, playerID)
+	g.kickPlayer(playerID)
+	return nil
+}
+
+// kickPlayer removes a player or spectator for good: they're added to
+// RemovedPlayers so they can't reconnect.
+func (g *Game) kickPlayer(playerID string) {
 	// Mark player as removed in the central set
 	g.Mu.Lock()
 	g.RemovedPlayers[playerID] = true
 
+	if spectator, isSpectator := g.Spectators[playerID]; isSpectator {
+		log.Printf("kickPlayer: Removing spectator %s (%s)", spectator.Username, playerID)
+		delete(g.Spectators, playerID)
+		g.Mu.Unlock()
+		if spectator.Client != nil {
+			spectator.Client.Close()
+		}
+		g.BroadcastGameState()
+		return
+	}
+
 	// Also handle the case if player is in temporary storage
 	var clientToClose shared.ClientInterface
 	if player, exists := g.Players[playerID]; exists {
-		log.Printf("RemovePlayerByHost: Marking player %s (%s) as removed by host %s", player.Username, playerID, hostID)
+		log.Printf("kickPlayer: Marking player %s (%s) as removed", player.Username, playerID)
 		clientToClose = player.Client
 		player.Client = nil // Prevent RemovePlayer from trying to close it again
 	} else if tempPlayer, inTempStorage := g.TempDisconnectedPlayers[playerID]; inTempStorage {
-		log.Printf("RemovePlayerByHost: Found player %s in temporary storage, marking for removal", playerID)
+		log.Printf("kickPlayer: Found player %s in temporary storage, marking for removal", playerID)
 		// Move player from temp storage to active players so RemovePlayer can handle it
 		tempPlayer.LeftAt = time.Now()
 		g.Players[playerID] = tempPlayer
 		delete(g.TempDisconnectedPlayers, playerID)
 	}
+	turn := g.CurrentTurn
 	g.Mu.Unlock()
 
 	// Use the existing RemovePlayer function for the actual removal
 	g.RemovePlayer(playerID)
 
+	// A kicked drawer's turn ends now rather than when the timer runs out
+	if turn.CurrentDrawerID == playerID {
+		g.skipTurn(turn, "the drawer was removed")
+	} else {
+		g.skipTurnIfNobodyCanGuess(turn)
+	}
+
 	// Close the client connection after removal is complete
 	if clientToClose != nil {
-		log.Printf("RemovePlayerByHost: Closing connection for removed player %s", playerID)
+		log.Printf("kickPlayer: Closing connection for removed player %s", playerID)
 		clientToClose.Close()
 	}
 
+	// A kicked host hands the room to someone else
+	g.migrateHostIfNeeded()
+
 	// Broadcast updated game state
 	g.BroadcastGameState()
-
-	return nil
 }
 
 func (g *Game) GetPlayerByID(playerID string) *shared.Player {
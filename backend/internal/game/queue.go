@@ -0,0 +1,80 @@
+package game
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+// QueuePlayer lets someone join a game that is already running. They watch
+// as a spectator until the next round begins, when admitQueuedPlayers gives
+// them a seat.
+func (g *Game) QueuePlayer(player *shared.Player) error {
+	g.Mu.Lock()
+	defer g.Mu.Unlock()
+
+	if g.Options.MaxPlayers > 0 && len(g.Players)+g.queuedCount() >= g.Options.MaxPlayers {
+		return errors.New("game is full")
+	}
+	if len(g.Spectators) >= maxSpectators {
+		return errors.New("too many players are waiting to join")
+	}
+
+	player.IsSpectator = true
+	player.Queued = true
+	g.Spectators[player.ID] = player
+	log.Printf("QueuePlayer: %s (%s) will join game %s next round", player.Username, player.ID, g.ID)
+	return nil
+}
+
+// admitQueuedPlayers moves connected queued players into the player order.
+// It runs between rounds, when Round.Next is about to reset the drawer index,
+// so appending to PlayerOrder can't shift the drawer of a round in progress.
+func (g *Game) admitQueuedPlayers() {
+	g.Mu.Lock()
+	var admitted []*shared.Player
+	for _, player := range g.spectatorList() {
+		if !player.Queued || !player.Connected {
+			continue
+		}
+		if g.Options.MaxPlayers > 0 && len(g.Players) >= g.Options.MaxPlayers {
+			break
+		}
+		delete(g.Spectators, player.ID)
+		player.IsSpectator = false
+		player.Queued = false
+		g.assignColor(player)
+		g.assignTeam(player)
+		g.Players[player.ID] = player
+		g.PlayerOrder = append(g.PlayerOrder, player.ID)
+		admitted = append(admitted, player)
+	}
+	g.Mu.Unlock()
+
+	for _, player := range admitted {
+		log.Printf("admitQueuedPlayers: %s (%s) joined game %s", player.Username, player.ID, g.ID)
+		g.recordPlayerJoin(player)
+		if b, err := utils.CreateMessage("playerJoined", map[string]any{"player": player}); err == nil {
+			g.Messenger.BroadcastMessage(b)
+		} else {
+			log.Println("error marshalling playerJoined message:", err)
+		}
+	}
+	if len(admitted) > 0 {
+		g.BroadcastGameState()
+	}
+}
+
+// queuedCount returns how many spectators are waiting for a seat. Callers
+// must hold g.Mu.
+func (g *Game) queuedCount() int {
+	count := 0
+	for _, s := range g.Spectators {
+		if s.Queued {
+			count++
+		}
+	}
+	return count
+}
@@ -0,0 +1,91 @@
+package game
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// stubMessenger records outgoing messages instead of sending them.
+type stubMessenger struct {
+	mu        sync.Mutex
+	broadcast [][]byte
+	direct    map[string][][]byte
+	events    chan e.GameEvent
+}
+
+func newStubMessenger() *stubMessenger {
+	return &stubMessenger{
+		direct: make(map[string][][]byte),
+		events: make(chan e.GameEvent),
+	}
+}
+
+func (m *stubMessenger) BroadcastMessage(message []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.broadcast = append(m.broadcast, message)
+}
+
+func (m *stubMessenger) SendToPlayer(playerID string, message []byte) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.direct[playerID] = append(m.direct[playerID], message)
+}
+
+func (m *stubMessenger) SendToOthers(playerID string, message []byte) {
+	m.BroadcastMessage(message)
+}
+
+func (m *stubMessenger) SendToPlayers(playerIDs []string, message []byte) {
+	for _, id := range playerIDs {
+		m.SendToPlayer(id, message)
+	}
+}
+
+func (m *stubMessenger) GameEventChannel() <-chan e.GameEvent {
+	return m.events
+}
+
+func newTestGame(t *testing.T, options shared.GameOptions, playerIDs ...string) *Game {
+	t.Helper()
+	g := NewGame(context.Background(), "test-game", options, newStubMessenger(), nil)
+	for i, id := range playerIDs {
+		p := g.NewPlayer(id, id, i == 0)
+		p.Connected = true
+		g.AddPlayer(p)
+	}
+	return g
+}
+
+func TestQueuedPlayersJoinAtRoundBoundary(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{MaxPlayers: 3}, "p1", "p2")
+	g.Status = InProgress
+	g.Round.CurrentDrawerIdx = 1
+
+	late := g.NewPlayer("late", "late", false)
+	require.NoError(t, g.QueuePlayer(late))
+	assert.True(t, late.IsSpectator)
+	assert.NotContains(t, g.PlayerOrder, "late")
+
+	tooLate := g.NewPlayer("too-late", "too-late", false)
+	assert.Error(t, g.QueuePlayer(tooLate), "queue counts toward MaxPlayers")
+
+	// Not connected yet, so they keep waiting.
+	g.admitQueuedPlayers()
+	assert.NotContains(t, g.PlayerOrder, "late")
+
+	late.Connected = true
+	g.admitQueuedPlayers()
+	assert.Equal(t, []string{"p1", "p2", "late"}, g.PlayerOrder)
+	assert.False(t, late.IsSpectator)
+	assert.False(t, late.Queued)
+	assert.NotEmpty(t, late.Color)
+	assert.NotContains(t, g.Spectators, "late")
+	assert.Equal(t, 1, g.Round.CurrentDrawerIdx)
+}
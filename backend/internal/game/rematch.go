@@ -0,0 +1,142 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+	"github.com/google/uuid"
+)
+
+// DeclineRematch lets a player say they won't stay for another game once
+// this one is over. They stay in the room until the host starts the rematch,
+// so decline can be taken back with decline set to false.
+func (g *Game) DeclineRematch(playerID string, decline bool) error {
+	g.Mu.Lock()
+	if _, exists := g.Players[playerID]; !exists {
+		g.Mu.Unlock()
+		return errors.New("player not found")
+	}
+	if g.Status != Finished {
+		g.Mu.Unlock()
+		return errors.New("the game isn't over yet")
+	}
+	if g.rematchDeclined == nil {
+		g.rematchDeclined = make(map[string]bool)
+	}
+	if decline {
+		g.rematchDeclined[playerID] = true
+	} else {
+		delete(g.rematchDeclined, playerID)
+	}
+	g.Mu.Unlock()
+
+	g.BroadcastGameState()
+	return nil
+}
+
+// Rematch puts a finished game back in the lobby so the same room can play
+// again. Connected players keep their seats, colors and teams, and the
+// options stay as they were; scores, rounds and ready flags start over.
+// Players who declined the rematch or never came back are let go.
+func (g *Game) Rematch(hostID string) error {
+	g.Mu.Lock()
+	host, exists := g.Players[hostID]
+	if !exists || !host.IsHost {
+		g.Mu.Unlock()
+		return errors.New("only the host can start a rematch")
+	}
+	if g.Status != Finished {
+		g.Mu.Unlock()
+		return errors.New("the game isn't over yet")
+	}
+
+	var leaving []*shared.Player
+	for _, id := range slices.Clone(g.PlayerOrder) {
+		player, exists := g.Players[id]
+		if exists && player.Connected && (id == hostID || !g.rematchDeclined[id]) {
+			continue
+		}
+		if !exists {
+			// Still inside their reconnect grace period
+			player, exists = g.TempDisconnectedPlayers[id]
+		}
+		if exists {
+			player.LeftAt = time.Now()
+			g.AvailableColors = append(g.AvailableColors, player.Color)
+			delete(g.Players, id)
+			leaving = append(leaving, player)
+		}
+		g.PlayerOrder = slices.DeleteFunc(g.PlayerOrder, func(pid string) bool { return pid == id })
+	}
+	g.TempDisconnectedPlayers = make(map[string]*shared.Player)
+	g.resetForRematch()
+	g.Mu.Unlock()
+
+	log.Printf("Rematch: host %s restarted game %s with %d players", hostID, g.ID, len(g.Players))
+	for _, player := range leaving {
+		if b, err := utils.CreateMessage("playerLeft", map[string]any{"player": player}); err == nil {
+			g.Messenger.BroadcastMessage(b)
+		} else {
+			log.Println("error marshalling playerLeft message:", err)
+		}
+		if player.Client != nil {
+			player.Client.Close()
+		}
+	}
+	if b, err := utils.CreateMessage("rematch", map[string]any{"hostID": hostID}); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling rematch message:", err)
+	}
+
+	// Anyone who was waiting for a seat gets one now
+	g.admitQueuedPlayers()
+	g.BroadcastGameState()
+	return nil
+}
+
+// resetForRematch clears everything the last game left behind. Callers must
+// hold g.Mu.
+func (g *Game) resetForRematch() {
+	for timerType, timer := range g.timers {
+		timer.Cancel()
+		delete(g.timers, timerType)
+	}
+	if g.voteKick != nil {
+		g.voteKick.timer.Stop()
+		g.voteKick = nil
+	}
+	for _, player := range g.Players {
+		player.Score = 0
+		player.Ready = false
+		player.IsDrawing = false
+		player.IsGuessCorrect = false
+	}
+	g.rematchDeclined = nil
+	g.playerStats = nil
+	g.playedWords = nil
+	g.TeamScores = make(map[int]int)
+	g.Round = InitRound()
+	g.CurrentTurn = InitTurn()
+	g.Paused = false
+	g.Status = NotStarted
+	g.lastActivity = time.Now()
+	// Each match gets its own history record
+	g.historyID = uuid.New().String()
+}
+
+// rematchDeclinedList returns who has declined the rematch, in turn order.
+// Callers must hold g.Mu.
+func (g *Game) rematchDeclinedList() []string {
+	var declined []string
+	for _, id := range g.PlayerOrder {
+		if g.rematchDeclined[id] {
+			declined = append(declined, id)
+		}
+	}
+	return declined
+}
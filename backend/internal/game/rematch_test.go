@@ -0,0 +1,46 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRematchResetsTheLobby(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{RoundLimit: 3}, "host", "stays", "leaves", "gone")
+	assert.Error(t, g.Rematch("host"), "the game isn't over")
+
+	g.Status = Finished
+	g.Round.Count = 3
+	g.TeamScores[1] = 40
+	for _, p := range g.Players {
+		p.Score = 100
+		p.Ready = true
+	}
+	color := g.Players["stays"].Color
+	goneColor := g.Players["gone"].Color
+	g.HandleDisconnect("gone")
+
+	require.NoError(t, g.DeclineRematch("leaves", true))
+	assert.Equal(t, []string{"leaves"}, g.GetGameState().RematchDeclined)
+	assert.Error(t, g.Rematch("stays"), "only the host can start a rematch")
+	require.NoError(t, g.Rematch("host"))
+
+	assert.Equal(t, NotStarted, g.Status)
+	assert.Equal(t, []string{"host", "stays"}, g.PlayerOrder)
+	assert.NotContains(t, g.Players, "leaves")
+	assert.Empty(t, g.TempDisconnectedPlayers)
+	assert.Equal(t, 0, g.Round.Count)
+	assert.Empty(t, g.TeamScores)
+	assert.Empty(t, g.GetGameState().RematchDeclined)
+	for _, p := range g.Players {
+		assert.Zero(t, p.Score)
+		assert.False(t, p.Ready)
+	}
+	assert.Equal(t, color, g.Players["stays"].Color, "colors carry over")
+	assert.Contains(t, g.AvailableColors, goneColor, "a player who never came back frees their color")
+	assert.NotEqual(t, g.ID, g.GetHistoryID(), "the rematch is recorded as a new match")
+	assert.Equal(t, g.GetHistoryID(), g.GetGameState().HistoryID)
+}
@@ -38,6 +38,7 @@ func (r *Round) Start(g *Game) {
 	if r.Count == 0 { // Only set the count on the very first round.
 		r.Count = 1
 	}
+	g.roundDeltas = make(map[string]int)
 	r.setInitialDrawer(g)
 	g.FlowSignal <- TurnStarted
 }
@@ -50,66 +51,67 @@ func (r *Round) Next(g *Game) {
 }
 
 func (r *Round) setInitialDrawer(g *Game) {
-	if len(g.PlayerOrder) == 0 {
-		return
-	}
-	firstID := g.PlayerOrder[0]
-	if player, exists := g.Players[firstID]; exists {
-		player.IsDrawing = true
-		r.CurrentDrawerID = firstID
-		r.CurrentDrawerIdx = 0
-		g.CurrentTurn = NewTurn(firstID)
-		msgType := "drawingPlayerChanged"
-		if b, err := utils.CreateMessage(msgType, player); err == nil {
-			g.Messenger.BroadcastMessage(b)
-		} else {
-			log.Println("error marshalling message:", err)
-		}
-	} else {
-		log.Printf("setInitialDrawer: Player %s not found in players map", firstID)
+	r.CurrentDrawerIdx = -1
+	if r.advanceDrawer(g) == nil {
+		log.Printf("setInitialDrawer: no player available to draw")
 	}
 }
 
 func (r *Round) NextDrawer(g *Game) *shared.Player {
 	g.Mu.Lock()
 	defer g.Mu.Unlock()
+	return r.advanceDrawer(g)
+}
 
-	if len(g.PlayerOrder) == 0 {
-		return nil
-	}
-	// Move to the next drawer.
-	r.CurrentDrawerIdx = (r.CurrentDrawerIdx + 1) % len(g.PlayerOrder)
-	newID := g.PlayerOrder[r.CurrentDrawerIdx]
-
-	// Check if the player exists
-	nextPlayer, exists := g.Players[newID]
-	if !exists {
-		log.Printf("NextDrawer: Player %s not found in players map", newID)
-		return nil
-	}
+// advanceDrawer hands the turn to the next player in order who is still in
+// the game and hasn't drawn this round. It returns nil once nobody is left
+// to draw. Callers must hold g.Mu.
+func (r *Round) advanceDrawer(g *Game) *shared.Player {
+	for step := 1; step <= len(g.PlayerOrder); step++ {
+		idx := (r.CurrentDrawerIdx + step) % len(g.PlayerOrder)
+		newID := g.PlayerOrder[idx]
+		nextPlayer, exists := g.Players[newID]
+		if !exists || slices.Contains(r.PlayersDrawn, newID) {
+			continue
+		}
+
+		r.CurrentDrawerIdx = idx
 
-	// Create a new turn for the new drawer.
-	g.CurrentTurn = NewTurn(newID)
+		// Create a new turn for the new drawer.
+		g.CurrentTurn = NewTurn(newID)
 
-	// Set the new drawer as active.
-	nextPlayer.IsDrawing = true
-	r.CurrentDrawerID = newID
+		// Set the new drawer as active.
+		nextPlayer.IsDrawing = true
+		r.CurrentDrawerID = newID
 
-	// Broadcast the change.
-	if b, err := utils.CreateMessage("drawingPlayerChanged", nextPlayer); err == nil {
-		g.Messenger.BroadcastMessage(b)
-	} else {
-		log.Println("error marshalling message:", err)
+		// Broadcast the change.
+		if b, err := utils.CreateMessage("drawingPlayerChanged", nextPlayer); err == nil {
+			g.Messenger.BroadcastMessage(b)
+		} else {
+			log.Println("error marshalling message:", err)
+		}
+		return nextPlayer
 	}
-	return nextPlayer
+	return nil
 }
 
-func (r *Round) GetCurrentDrawer(players map[string]*shared.Player, playerOrder []string) *shared.Player {
-	if len(playerOrder) == 0 {
-		return nil
+// removeFromOrder drops playerID from the player order, keeping
+// CurrentDrawerIdx on the same drawer. If the drawer is the one leaving, the
+// index steps back so the player who slides into their slot draws next.
+// Callers must hold g.Mu.
+func (r *Round) removeFromOrder(g *Game, playerID string) {
+	i := slices.Index(g.PlayerOrder, playerID)
+	if i < 0 {
+		return
 	}
-	currentID := playerOrder[r.CurrentDrawerIdx]
-	return players[currentID]
+	g.PlayerOrder = slices.Delete(g.PlayerOrder, i, i+1)
+	if i <= r.CurrentDrawerIdx {
+		r.CurrentDrawerIdx--
+	}
+}
+
+func (r *Round) GetCurrentDrawer(players map[string]*shared.Player) *shared.Player {
+	return players[r.CurrentDrawerID]
 }
 
 func (r *Round) MarkPlayerAsDrawn(playerID string) {
@@ -119,8 +121,14 @@ func (r *Round) MarkPlayerAsDrawn(playerID string) {
 	r.PlayersDrawn = append(r.PlayersDrawn, playerID)
 }
 
+// IsOver reports whether everyone still in the game has drawn this round.
 func (r *Round) IsOver(g *Game) bool {
-	return len(r.PlayersDrawn) == len(g.PlayerOrder)
+	for _, id := range g.PlayerOrder {
+		if _, exists := g.Players[id]; exists && !slices.Contains(r.PlayersDrawn, id) {
+			return false
+		}
+	}
+	return true
 }
 
 func (r *Round) UnmarkAllPlayersAsDrawn() {
@@ -0,0 +1,76 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNextDrawerSkipsMissingPlayers(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "a", "b", "c", "d")
+	g.Round.setInitialDrawer(g)
+	assert.Equal(t, "a", g.Round.CurrentDrawerID)
+
+	// b is inside the reconnect grace period, so c draws next
+	g.Round.MarkPlayerAsDrawn("a")
+	g.TempDisconnectedPlayers = map[string]*shared.Player{"b": g.Players["b"]}
+	delete(g.Players, "b")
+	require.NotNil(t, g.Round.NextDrawer(g))
+	assert.Equal(t, "c", g.Round.CurrentDrawerID)
+
+	// The current drawer leaves: whoever slides into their slot is next
+	g.RemovePlayer("c")
+	assert.Equal(t, []string{"a", "b", "d"}, g.PlayerOrder)
+	require.NotNil(t, g.Round.NextDrawer(g))
+	assert.Equal(t, "d", g.Round.CurrentDrawerID)
+	assert.Equal(t, 2, g.Round.CurrentDrawerIdx)
+
+	// Someone before the drawer leaves: the index follows the drawer
+	g.RemovePlayer("a")
+	assert.Equal(t, 1, g.Round.CurrentDrawerIdx)
+	assert.Equal(t, "d", g.PlayerOrder[g.Round.CurrentDrawerIdx])
+
+	g.Round.MarkPlayerAsDrawn("d")
+	assert.True(t, g.Round.IsOver(g), "b isn't here, so the round is done")
+	assert.Nil(t, g.Round.NextDrawer(g))
+}
+
+func TestSkipTurnOnlyEndsItsOwnTurn(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "a", "b")
+	g.Status = InProgress
+	g.Round.setInitialDrawer(g)
+	turn := g.CurrentTurn
+
+	g.skipTurn(NewTurn("a"), "stale")
+	assert.Empty(t, g.FlowSignal)
+
+	g.skipTurn(turn, "the drawer disconnected")
+	assert.Equal(t, TurnEnded, <-g.FlowSignal)
+
+	// A second reason to skip the same turn doesn't end it twice
+	g.skipTurn(turn, "the drawer has no teammates to guess")
+	assert.Empty(t, g.FlowSignal)
+}
+
+func TestDrawerDisconnectsWhileSelectingWord(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "a", "b")
+	g.Status = InProgress
+	g.Round.setInitialDrawer(g)
+	g.CurrentTurn.IsSelectingWord = true
+	g.CurrentTurn.SelectableWords = []shared.Word{{Word: "Rocket"}}
+	timer := NewTimer(g.ctx, "selectWordTimer", 10)
+	g.timers["selectWordTimer"] = timer
+
+	// The word choice waits for the drawer
+	g.HandleDisconnect("a")
+	assert.True(t, timer.paused)
+	require.True(t, g.HandleReconnect("a"))
+	assert.False(t, timer.paused)
+
+	// Running out of time without the drawer still picks a word
+	g.HandleDisconnect("a")
+	assert.NotPanics(t, g.handleTimerExpiration)
+	assert.Equal(t, "Rocket", g.CurrentTurn.WordToGuess.Word)
+}
@@ -0,0 +1,143 @@
+package game
+
+import (
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+)
+
+// Reasons recorded in a turn's score breakdown.
+const (
+	ScoreReasonGuess       = "guess"
+	ScoreReasonDrawer      = "drawer"
+	ScoreReasonDrawerBonus = "drawerBonus"
+)
+
+// ScoreContext describes a correct guess for a ScoringPolicy.
+type ScoreContext struct {
+	Remaining  int     // Seconds left on the turn timer
+	Duration   int     // Full length of the turn timer in seconds
+	Place      int     // 1 for the first correct guess of the turn, 2 for the second...
+	Guessers   int     // Players who can score this turn
+	Multiplier float64 // Word difficulty multiplier
+}
+
+// ScoringPolicy decides how many points a turn hands out.
+type ScoringPolicy interface {
+	// GuesserPoints is what a player earns for guessing the word.
+	GuesserPoints(ctx ScoreContext) int
+	// DrawerPoints is what the drawer earns each time someone guesses.
+	DrawerPoints(ctx ScoreContext) int
+	// DrawerBonus is what the drawer earns once everyone has guessed.
+	DrawerBonus(ctx ScoreContext) int
+}
+
+// ScoreEntry is one line of a turn's score breakdown.
+type ScoreEntry struct {
+	PlayerID string `json:"playerID"`
+	Points   int    `json:"points"`
+	Reason   string `json:"reason"`
+	Place    int    `json:"place,omitempty"`
+}
+
+var scoringPolicies = map[string]ScoringPolicy{
+	shared.ScoringLinear:      linearScoring{},
+	shared.ScoringPlacement:   placementScoring{},
+	shared.ScoringDrawerShare: drawerShareScoring{},
+}
+
+// scoringPolicyFor returns the named built-in policy, falling back to linear
+// scoring for unknown names.
+func scoringPolicyFor(name string) ScoringPolicy {
+	if policy, ok := scoringPolicies[name]; ok {
+		return policy
+	}
+	return linearScoring{}
+}
+
+// linearScoring pays up to 100 points by time remaining, and the drawer 100
+// points when everyone guesses.
+type linearScoring struct{}
+
+func (linearScoring) GuesserPoints(ctx ScoreContext) int {
+	if ctx.Duration == 0 {
+		return 0
+	}
+	return int(100 * (float64(ctx.Remaining) / float64(ctx.Duration)) * ctx.Multiplier)
+}
+
+func (linearScoring) DrawerPoints(ScoreContext) int { return 0 }
+
+func (linearScoring) DrawerBonus(ctx ScoreContext) int {
+	return int(100 * ctx.Multiplier)
+}
+
+var placementPoints = []int{150, 100, 75}
+
+const placementFloor = 50
+
+// placementScoring pays by guess order: 150, 100 and 75 points for the first
+// three guessers and 50 for everyone after. The drawer bonus is unchanged.
+type placementScoring struct{}
+
+func (placementScoring) GuesserPoints(ctx ScoreContext) int {
+	points := placementFloor
+	if ctx.Place >= 1 && ctx.Place <= len(placementPoints) {
+		points = placementPoints[ctx.Place-1]
+	}
+	return int(float64(points) * ctx.Multiplier)
+}
+
+func (placementScoring) DrawerPoints(ScoreContext) int { return 0 }
+
+func (placementScoring) DrawerBonus(ctx ScoreContext) int {
+	return int(100 * ctx.Multiplier)
+}
+
+// drawerShareScoring pays guessers like linearScoring, but splits the
+// drawer's 100 points across guessers so a drawing half the room gets still
+// earns something.
+type drawerShareScoring struct{}
+
+func (drawerShareScoring) GuesserPoints(ctx ScoreContext) int {
+	return linearScoring{}.GuesserPoints(ctx)
+}
+
+func (drawerShareScoring) DrawerPoints(ctx ScoreContext) int {
+	if ctx.Guessers == 0 {
+		return 0
+	}
+	return int(100 * ctx.Multiplier / float64(ctx.Guessers))
+}
+
+func (drawerShareScoring) DrawerBonus(ScoreContext) int { return 0 }
+
+// scoreContext describes the current turn for the scoring policy. Callers
+// must hold g.Mu.
+func (g *Game) scoreContext() ScoreContext {
+	ctx := ScoreContext{
+		Remaining:  g.GetRemainingTime("turnTimer"),
+		Place:      len(g.CurrentTurn.PlayersGuessedCorrectly) + 1,
+		Guessers:   len(g.eligibleGuessers()),
+		Multiplier: difficultyMultiplier(g.CurrentTurn.WordToGuess),
+	}
+	if timer, exists := g.timers["turnTimer"]; exists {
+		ctx.Duration = timer.duration
+	}
+	return ctx
+}
+
+// awardPoints credits a player and their team and records it in the turn's
+// score breakdown. Callers must hold g.Mu.
+func (g *Game) awardPoints(playerID string, points int, reason string, place int) {
+	player, exists := g.Players[playerID]
+	if !exists || points <= 0 {
+		return
+	}
+	player.Score += points
+	g.creditTeam(playerID, points)
+	g.CurrentTurn.ScoreBreakdown = append(g.CurrentTurn.ScoreBreakdown, ScoreEntry{
+		PlayerID: playerID,
+		Points:   points,
+		Reason:   reason,
+		Place:    place,
+	})
+}
@@ -0,0 +1,45 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoringPolicies(t *testing.T) {
+	half := ScoreContext{Remaining: 30, Duration: 60, Place: 2, Guessers: 4, Multiplier: 1}
+
+	linear := scoringPolicyFor(shared.ScoringLinear)
+	assert.Equal(t, 50, linear.GuesserPoints(half))
+	assert.Equal(t, 0, linear.DrawerPoints(half))
+	assert.Equal(t, 100, linear.DrawerBonus(half))
+
+	placement := scoringPolicyFor(shared.ScoringPlacement)
+	assert.Equal(t, 100, placement.GuesserPoints(half))
+	assert.Equal(t, 50, placement.GuesserPoints(ScoreContext{Place: 7, Multiplier: 1}))
+	assert.Equal(t, 225, placement.GuesserPoints(ScoreContext{Place: 1, Multiplier: 1.5}))
+
+	share := scoringPolicyFor(shared.ScoringDrawerShare)
+	assert.Equal(t, 25, share.DrawerPoints(half))
+	assert.Equal(t, 0, share.DrawerBonus(half))
+
+	assert.Equal(t, linearScoring{}, scoringPolicyFor("unknown"))
+}
+
+func TestAwardPointsRecordsBreakdown(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TeamMode: true}, "a", "b")
+	g.CurrentTurn = NewTurn("a")
+
+	g.awardPoints("b", 80, ScoreReasonGuess, 1)
+	g.awardPoints("a", 0, ScoreReasonDrawer, 0)
+	g.awardPoints("a", 100, ScoreReasonDrawerBonus, 0)
+
+	assert.Equal(t, 80, g.Players["b"].Score)
+	assert.Equal(t, 100, g.Players["a"].Score)
+	assert.Equal(t, 80, g.TeamScores[g.Players["b"].Team])
+	assert.Equal(t, []ScoreEntry{
+		{PlayerID: "b", Points: 80, Reason: ScoreReasonGuess, Place: 1},
+		{PlayerID: "a", Points: 100, Reason: ScoreReasonDrawerBonus},
+	}, g.CurrentTurn.ScoreBreakdown)
+}
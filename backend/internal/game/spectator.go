@@ -0,0 +1,80 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+)
+
+const maxSpectators = 20
+
+// AddSpectator lets someone watch the game. Spectators receive every
+// broadcast but never enter the player order, so they can't draw, guess or
+// score.
+func (g *Game) AddSpectator(player *shared.Player) error {
+	g.Mu.Lock()
+	defer g.Mu.Unlock()
+	if _, exists := g.Spectators[player.ID]; exists {
+		return nil
+	}
+	if len(g.Spectators) >= maxSpectators {
+		return errors.New("this game has reached its spectator limit")
+	}
+	player.IsSpectator = true
+	g.Spectators[player.ID] = player
+	log.Printf("AddSpectator: %s (%s) is now watching game %s", player.Username, player.ID, g.ID)
+	return nil
+}
+
+func (g *Game) GetSpectatorByID(playerID string) *shared.Player {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.Spectators[playerID]
+}
+
+func (g *Game) RemoveSpectator(playerID string) {
+	g.Mu.Lock()
+	defer g.Mu.Unlock()
+	delete(g.Spectators, playerID)
+}
+
+// handleSpectatorDisconnect keeps a spectator's seat for the reconnect grace
+// period so a page refresh doesn't drop them from the game.
+func (g *Game) handleSpectatorDisconnect(spectator *shared.Player) {
+	g.Mu.Lock()
+	spectator.Connected = false
+	spectator.Client = nil
+	g.Mu.Unlock()
+	g.BroadcastGameState()
+
+	time.AfterFunc(time.Duration(PlayerReconnectGracePeriod)*time.Second, func() {
+		g.Mu.Lock()
+		current, exists := g.Spectators[spectator.ID]
+		stillDisconnected := exists && current == spectator && !spectator.Connected
+		if stillDisconnected {
+			delete(g.Spectators, spectator.ID)
+		}
+		g.Mu.Unlock()
+
+		if stillDisconnected {
+			log.Printf("handleSpectatorDisconnect: grace period expired for spectator %s", spectator.ID)
+			g.BroadcastGameState()
+		}
+	})
+}
+
+// spectatorList returns spectators in the order they joined. Callers must
+// hold g.Mu.
+func (g *Game) spectatorList() []*shared.Player {
+	spectators := make([]*shared.Player, 0, len(g.Spectators))
+	for _, s := range g.Spectators {
+		spectators = append(spectators, s)
+	}
+	slices.SortFunc(spectators, func(a, b *shared.Player) int {
+		return a.JoinedAt.Compare(b.JoinedAt)
+	})
+	return spectators
+}
@@ -3,8 +3,11 @@ package game
 import (
 	"encoding/json"
 	"log"
+	"maps"
 
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
 )
 
 type Status int
@@ -17,50 +20,155 @@ const (
 
 type GameState struct {
 	ID              string             `json:"id"`
+	HistoryID       string             `json:"historyID"` // Changes with every rematch
+	Code            string             `json:"code"`
 	Players         []*shared.Player   `json:"players"`
 	PlayerOrder     []string           `json:"playerOrder"`
 	CurrentDrawerID string             `json:"currentDrawerID"`
 	Options         shared.GameOptions `json:"options"`
 	Status          Status             `json:"status"`
+	Paused          bool               `json:"paused"`
 	Round           *Round             `json:"round"`
 	Turn            *Turn              `json:"turn"`
 	WordToGuess     *shared.Word       `json:"wordToGuess,omitempty"`
 	IsSelectingWord bool               `json:"isSelectingWord"`
+	Spectators      []*shared.Player   `json:"spectators"`
+	Teams           []TeamState        `json:"teams,omitempty"`
+	VoteKick        *VoteKickState     `json:"voteKick,omitempty"`
+	RematchDeclined []string           `json:"rematchDeclined,omitempty"`
 }
 
+// GetGameState returns the state as every player may see it. The host's
+// custom and blocked word lists are left out so nobody can read the answers
+// ahead of time, and so is the word until the turn summary reveals it; see
+// gameStateFor.
 func (g *Game) GetGameState() GameState {
 	g.Mu.RLock()
 	defer g.Mu.RUnlock()
+	return g.gameState("")
+}
+
+// gameStateFor returns the state as playerID may see it. Only the host gets
+// the word lists, since they edit the options and send them back whole, and
+// only the drawer gets the word they're choosing or drawing.
+func (g *Game) gameStateFor(playerID string) GameState {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.gameState(playerID)
+}
+
+// gameState builds the state as viewerID sees it; "" is the view every
+// player shares. Callers must hold g.Mu.
+func (g *Game) gameState(viewerID string) GameState {
 	orderedPlayers := make([]*shared.Player, 0, len(g.PlayerOrder))
 	for _, id := range g.PlayerOrder {
 		if player, exists := g.Players[id]; exists {
 			orderedPlayers = append(orderedPlayers, player)
 		}
 	}
+	options := g.Options
+	if viewer, exists := g.Players[viewerID]; !exists || !viewer.IsHost {
+		options.CustomWords = nil
+		options.BlockedWords = nil
+	}
 	return GameState{
 		ID:              g.ID,
+		HistoryID:       g.historyID,
+		Code:            g.Code,
 		Players:         orderedPlayers,
 		PlayerOrder:     g.PlayerOrder,
 		CurrentDrawerID: g.Round.CurrentDrawerID,
-		Options:         g.Options,
+		Options:         options,
 		Status:          g.Status,
+		Paused:          g.Paused,
 		Round:           g.Round,
-		Turn:            g.CurrentTurn,
+		Turn:            g.turnFor(viewerID),
 		IsSelectingWord: g.CurrentTurn.IsSelectingWord,
+		Spectators:      g.spectatorList(),
+		Teams:           g.teamStates(),
+		VoteKick:        g.voteKickState(),
+		RematchDeclined: g.rematchDeclinedList(),
 	}
 }
 
+// turnFor returns a copy of the current turn as viewerID sees it: everyone
+// but the drawer only learns the word once the turn summary reveals it.
+// Callers must hold g.Mu.
+func (g *Game) turnFor(viewerID string) *Turn {
+	turn := *g.CurrentTurn
+	turn.PlayersGuessedCorrectly = maps.Clone(turn.PlayersGuessedCorrectly)
+	if viewerID != turn.CurrentDrawerID && turn.Phase < PhaseTurnSummary {
+		turn.WordToGuess = nil
+		turn.SelectableWords = nil
+	}
+	return &turn
+}
+
+// BroadcastGameState sends everyone the game state. The host and the drawer
+// each get their own view; everyone else shares one.
 func (g *Game) BroadcastGameState() {
-	state := g.GetGameState()
+	g.Mu.RLock()
+	state := g.gameState("")
+	private := make(map[string]GameState, 2)
+	for _, id := range []string{g.hostID(), g.CurrentTurn.CurrentDrawerID} {
+		if _, exists := g.Players[id]; exists {
+			private[id] = g.gameState(id)
+		}
+	}
+	var others []string
+	if len(private) > 0 {
+		for id := range g.Players {
+			if _, own := private[id]; !own {
+				others = append(others, id)
+			}
+		}
+		for id := range g.Spectators {
+			others = append(others, id)
+		}
+	}
+	g.Mu.RUnlock()
+
 	b, err := json.Marshal(map[string]any{
 		"type":    "gameState",
 		"payload": state,
 	})
 	if err != nil {
 		log.Println("error marshalling game state:", err)
+		return
+	}
+	if len(private) == 0 {
+		g.Messenger.BroadcastMessage(b)
+		return
+	}
+	g.Messenger.SendToPlayers(others, b)
+	for id, own := range private {
+		if b, err := utils.CreateMessage("gameState", own); err == nil {
+			g.Messenger.SendToPlayer(id, b)
+		} else {
+			log.Println("error marshalling game state:", err)
+		}
+	}
+}
 
+// hostID returns the current host's ID, or "" if the room has none. Callers
+// must hold g.Mu.
+func (g *Game) hostID() string {
+	for _, player := range g.Players {
+		if player.IsHost {
+			return player.ID
+		}
+	}
+	return ""
+}
+
+// sendError notifies a single player that their last action was rejected.
+func (g *Game) sendError(playerID, message string) {
+	b, err := utils.CreateMessage(string(e.EvtErrorNotification), e.ErrorNotificationPayload{Message: message})
+	if err != nil {
+		log.Println("error marshalling errorNotification message:", err)
+		return
 	}
-	g.Messenger.BroadcastMessage(b)
+	g.Messenger.SendToPlayer(playerID, b)
 }
 
 func (g *Game) String() string {
@@ -0,0 +1,134 @@
+package game
+
+import (
+	"log"
+	"slices"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+// summarizeTurn adds a finished turn to the round's totals and the
+// end-of-game summary: who guessed the word, how fast, and where the points
+// came from. Callers must hold g.Mu.
+func (g *Game) summarizeTurn(t *Turn) {
+	if g.roundDeltas == nil {
+		g.roundDeltas = make(map[string]int)
+	}
+	for _, entry := range t.ScoreBreakdown {
+		g.roundDeltas[entry.PlayerID] += entry.Points
+		stats := g.statsFor(entry.PlayerID)
+		if entry.Reason == ScoreReasonGuess {
+			stats.GuesserPoints += entry.Points
+		} else {
+			stats.DrawerPoints += entry.Points
+		}
+	}
+	for _, guess := range t.guesses {
+		stats := g.statsFor(guess.PlayerID)
+		stats.CorrectGuesses++
+		if stats.CorrectGuesses == 1 || guess.TimeMs < stats.FastestGuessMs {
+			stats.FastestGuessMs = guess.TimeMs
+		}
+	}
+
+	if t.WordToGuess == nil {
+		return
+	}
+	g.playedWords = append(g.playedWords, e.WordSummary{
+		Round:     g.Round.Count,
+		DrawerID:  t.CurrentDrawerID,
+		Word:      t.WordToGuess.Word,
+		Category:  t.WordToGuess.Category,
+		GuessedBy: append([]e.WordGuesser{}, t.guesses...),
+	})
+}
+
+// statsFor returns playerID's running stats. Callers must hold g.Mu.
+func (g *Game) statsFor(playerID string) *e.PlayerGameStats {
+	if g.playerStats == nil {
+		g.playerStats = make(map[string]*e.PlayerGameStats)
+	}
+	stats, exists := g.playerStats[playerID]
+	if !exists {
+		stats = &e.PlayerGameStats{}
+		g.playerStats[playerID] = stats
+	}
+	return stats
+}
+
+// rankedPlayers returns the players by score, best first, with their ranks.
+// Tied players share a rank and the next rank is skipped. Players waiting
+// out a disconnect are still ranked; players who left for good are not.
+// Callers must hold g.Mu.
+func (g *Game) rankedPlayers() ([]*shared.Player, []int) {
+	var players []*shared.Player
+	for _, id := range g.PlayerOrder {
+		if player, exists := g.Players[id]; exists {
+			players = append(players, player)
+		} else if player, exists := g.TempDisconnectedPlayers[id]; exists {
+			players = append(players, player)
+		}
+	}
+	slices.SortStableFunc(players, func(a, b *shared.Player) int {
+		return b.Score - a.Score
+	})
+
+	ranks := make([]int, len(players))
+	for i, player := range players {
+		ranks[i] = i + 1
+		if i > 0 && player.Score == players[i-1].Score {
+			ranks[i] = ranks[i-1]
+		}
+	}
+	return players, ranks
+}
+
+// outrightWinner returns the top scorer from players, ranked best first, or
+// nil if nobody played or first place is shared.
+func outrightWinner(players []*shared.Player) *shared.Player {
+	if len(players) == 0 || (len(players) > 1 && players[1].Score == players[0].Score) {
+		return nil
+	}
+	return players[0]
+}
+
+// gameSummary builds the final standings. Callers must hold g.Mu.
+func (g *Game) gameSummary() e.GameEndedPayload {
+	players, ranks := g.rankedPlayers()
+	summary := e.GameEndedPayload{
+		HistoryID: g.historyID,
+		Scores:    make(map[string]int, len(players)),
+		Ranking:   make([]e.RankingEntry, 0, len(players)),
+		Words:     g.playedWords,
+	}
+	for i, player := range players {
+		summary.Scores[player.ID] = player.Score
+		summary.Ranking = append(summary.Ranking, e.RankingEntry{
+			Rank:   ranks[i],
+			Player: player,
+			Score:  player.Score,
+			Stats:  *g.statsFor(player.ID),
+		})
+	}
+	summary.Winner = outrightWinner(players)
+	if summary.Words == nil {
+		summary.Words = []e.WordSummary{}
+	}
+	return summary
+}
+
+// broadcastGameSummary sends the final standings so clients can show the
+// podium without piecing it together from earlier messages.
+func (g *Game) broadcastGameSummary() {
+	g.Mu.RLock()
+	summary := g.gameSummary()
+	g.Mu.RUnlock()
+
+	if b, err := utils.CreateMessage(string(e.EvtGameEnded), summary); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling gameEnded message:", err)
+	}
+}
@@ -0,0 +1,56 @@
+package game
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGameSummary(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TurnTimeLimit: 60}, "alice", "bob", "carol")
+	g.Status = InProgress
+	g.Round.Count = 1
+
+	g.CurrentTurn = NewTurn("alice")
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly", Category: "Animals"}
+	g.CurrentTurn.drawingStartedAt = g.lastActivity
+	g.timers["turnTimer"] = NewTimer(context.Background(), "turnTimer", 60)
+	g.handlePlayerGuess("bob", "butterfly")
+	g.summarizeTurn(g.CurrentTurn)
+
+	// A turn skipped before a word was picked adds no word
+	g.summarizeTurn(NewTurn("bob"))
+
+	bob, alice := g.Players["bob"].Score, g.Players["alice"].Score
+	require.Positive(t, bob)
+	g.Players["carol"].Score = bob
+
+	summary := g.gameSummary()
+	assert.Equal(t, g.ID, summary.HistoryID, "the first match is recorded under the game's ID")
+	require.Len(t, summary.Ranking, 3)
+	assert.Nil(t, summary.Winner, "first place is shared")
+	assert.Equal(t, "bob", summary.Ranking[0].Player.ID)
+	assert.Equal(t, 1, summary.Ranking[0].Rank)
+	assert.Equal(t, "carol", summary.Ranking[1].Player.ID)
+	assert.Equal(t, 1, summary.Ranking[1].Rank)
+	assert.Equal(t, 3, summary.Ranking[2].Rank)
+	assert.Equal(t, alice, summary.Scores["alice"])
+
+	bobStats := summary.Ranking[0].Stats
+	assert.Equal(t, 1, bobStats.CorrectGuesses)
+	assert.Equal(t, bob, bobStats.GuesserPoints)
+	assert.Equal(t, alice, summary.Ranking[2].Stats.DrawerPoints)
+
+	require.Len(t, summary.Words, 1)
+	assert.Equal(t, "Butterfly", summary.Words[0].Word)
+	assert.Equal(t, "alice", summary.Words[0].DrawerID)
+	require.Len(t, summary.Words[0].GuessedBy, 1)
+	assert.Equal(t, "bob", summary.Words[0].GuessedBy[0].PlayerID)
+	assert.Equal(t, 1, summary.Words[0].GuessedBy[0].Place)
+
+	g.Players["carol"].Score = 0
+	assert.Equal(t, "bob", g.gameSummary().Winner.ID)
+}
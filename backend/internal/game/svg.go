@@ -0,0 +1,164 @@
+package game
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+)
+
+const (
+	svgWidth      = 800
+	svgHeight     = 600
+	svgBackground = "#FFFFFF"
+)
+
+// svgShape is a shape on the rendered canvas along with any fill applied to
+// it after it was drawn.
+type svgShape struct {
+	shape e.ShapePayload
+	fill  string
+}
+
+// RenderCanvasSVG draws canvas ops as a standalone SVG document. The client
+// flood-fills pixels, which has no vector equivalent, so a fill is
+// approximated: inside a closed shape it colors that shape, anywhere else it
+// recolors the background.
+func RenderCanvasSVG(ops []e.CanvasOp) string {
+	background := svgBackground
+	// items holds strokes and shapes in draw order.
+	var items []any
+	var shapes []*svgShape
+
+	for _, op := range ops {
+		switch p := op.Payload.(type) {
+		case e.StrokePayload:
+			items = append(items, p)
+		case e.ShapePayload:
+			s := &svgShape{shape: p}
+			if p.Filled {
+				s.fill = p.Color
+			}
+			shapes = append(shapes, s)
+			items = append(items, s)
+		case e.FillPayload:
+			if s := topShapeAt(shapes, p.Point); s != nil {
+				s.fill = p.Color
+			} else {
+				background = p.Color
+			}
+		}
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		svgWidth, svgHeight, svgWidth, svgHeight)
+	fmt.Fprintf(&b, `<rect width="100%%" height="100%%" fill="%s"/>`, background)
+
+	for _, item := range items {
+		switch it := item.(type) {
+		case e.StrokePayload:
+			writeStroke(&b, it, background)
+		case *svgShape:
+			writeShape(&b, it)
+		}
+	}
+
+	b.WriteString(`</svg>`)
+	return b.String()
+}
+
+func writeStroke(b *strings.Builder, stroke e.StrokePayload, background string) {
+	color := stroke.Color
+	if stroke.Tool == "eraser" {
+		color = background
+	}
+
+	points := stroke.Points
+	// A single point is a dot; repeating it gives the round cap something to draw.
+	if len(points) == 1 {
+		points = []e.Point{points[0], points[0]}
+	}
+
+	coords := make([]string, 0, len(points))
+	for _, p := range points {
+		x, y := toSVG(p)
+		coords = append(coords, fmt.Sprintf("%.1f,%.1f", x, y))
+	}
+	fmt.Fprintf(b, `<polyline points="%s" fill="none" stroke="%s" stroke-width="%.1f" stroke-linecap="round" stroke-linejoin="round"/>`,
+		strings.Join(coords, " "), color, stroke.Size)
+}
+
+func writeShape(b *strings.Builder, s *svgShape) {
+	shape := s.shape
+	fill := "none"
+	if s.fill != "" {
+		fill = s.fill
+	}
+	x1, y1 := toSVG(shape.Start)
+	x2, y2 := toSVG(shape.End)
+	minX, maxX := math.Min(x1, x2), math.Max(x1, x2)
+	minY, maxY := math.Min(y1, y2), math.Max(y1, y2)
+	style := fmt.Sprintf(`fill="%s" stroke="%s" stroke-width="%.1f"`, fill, shape.Color, shape.Size)
+
+	switch shape.Shape {
+	case "line":
+		fmt.Fprintf(b, `<line x1="%.1f" y1="%.1f" x2="%.1f" y2="%.1f" stroke="%s" stroke-width="%.1f" stroke-linecap="round"/>`,
+			x1, y1, x2, y2, shape.Color, shape.Size)
+	case "rectangle":
+		fmt.Fprintf(b, `<rect x="%.1f" y="%.1f" width="%.1f" height="%.1f" %s/>`,
+			minX, minY, maxX-minX, maxY-minY, style)
+	case "circle":
+		fmt.Fprintf(b, `<ellipse cx="%.1f" cy="%.1f" rx="%.1f" ry="%.1f" %s/>`,
+			(minX+maxX)/2, (minY+maxY)/2, (maxX-minX)/2, (maxY-minY)/2, style)
+	case "triangle":
+		fmt.Fprintf(b, `<polygon points="%.1f,%.1f %.1f,%.1f %.1f,%.1f" %s/>`,
+			(minX+maxX)/2, minY, maxX, maxY, minX, maxY, style)
+	}
+}
+
+// topShapeAt returns the most recently drawn closed shape containing p.
+func topShapeAt(shapes []*svgShape, p e.Point) *svgShape {
+	for i := len(shapes) - 1; i >= 0; i-- {
+		if shapeContains(shapes[i].shape, p) {
+			return shapes[i]
+		}
+	}
+	return nil
+}
+
+func shapeContains(shape e.ShapePayload, p e.Point) bool {
+	minX, maxX := math.Min(shape.Start.X, shape.End.X), math.Max(shape.Start.X, shape.End.X)
+	minY, maxY := math.Min(shape.Start.Y, shape.End.Y), math.Max(shape.Start.Y, shape.End.Y)
+	if p.X < minX || p.X > maxX || p.Y < minY || p.Y > maxY {
+		return false
+	}
+
+	switch shape.Shape {
+	case "rectangle":
+		return true
+	case "circle":
+		rx, ry := (maxX-minX)/2, (maxY-minY)/2
+		if rx == 0 || ry == 0 {
+			return false
+		}
+		dx, dy := (p.X-(minX+rx))/rx, (p.Y-(minY+ry))/ry
+		return dx*dx+dy*dy <= 1
+	case "triangle":
+		// The apex sits at the top middle, so the half-width at p's height
+		// grows linearly from the apex down to the base.
+		height := maxY - minY
+		if height == 0 {
+			return false
+		}
+		halfWidth := (maxX - minX) / 2 * (p.Y - minY) / height
+		return math.Abs(p.X-(minX+maxX)/2) <= halfWidth
+	default:
+		return false
+	}
+}
+
+func toSVG(p e.Point) (float64, float64) {
+	return p.X * svgWidth, p.Y * svgHeight
+}
@@ -0,0 +1,33 @@
+package game
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderCanvasSVGFromStoredOps(t *testing.T) {
+	ops := []e.CanvasOp{
+		{Type: e.DrawStroke, Payload: e.StrokePayload{ID: "s1", Tool: "pencil", Points: []e.Point{{X: 0, Y: 0}, {X: 0.5, Y: 0.5}}, Color: "#FF0000", Size: 3}},
+		{Type: e.DrawShape, Payload: e.ShapePayload{ID: "r1", Shape: "rectangle", Start: e.Point{X: 0.1, Y: 0.1}, End: e.Point{X: 0.3, Y: 0.3}, Color: "#000000", Size: 2}},
+		{Type: e.FillArea, Payload: e.FillPayload{ID: "f1", Point: e.Point{X: 0.2, Y: 0.2}, Color: "#00FF00"}},
+		{Type: e.FillArea, Payload: e.FillPayload{ID: "f2", Point: e.Point{X: 0.9, Y: 0.9}, Color: "#0000FF"}},
+	}
+
+	// Ops read back from the database must render the same as in-memory ones.
+	stored, err := json.Marshal(ops)
+	require.NoError(t, err)
+	var decoded []e.CanvasOp
+	require.NoError(t, json.Unmarshal(stored, &decoded))
+	assert.Equal(t, ops, decoded)
+
+	svg := RenderCanvasSVG(decoded)
+	assert.True(t, strings.HasPrefix(svg, "<svg"))
+	assert.Contains(t, svg, `<rect width="100%" height="100%" fill="#0000FF"/>`, "fill outside shapes recolors the background")
+	assert.Contains(t, svg, `<polyline points="0.0,0.0 400.0,300.0" fill="none" stroke="#FF0000"`)
+	assert.Contains(t, svg, `<rect x="80.0" y="60.0" width="160.0" height="120.0" fill="#00FF00" stroke="#000000"`, "fill inside the rectangle colors it")
+}
@@ -0,0 +1,167 @@
+package game
+
+import (
+	"errors"
+	"log"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+const defaultTeamCount = 2
+
+type TeamState struct {
+	ID      int      `json:"id"`
+	Players []string `json:"players"`
+	Score   int      `json:"score"`
+}
+
+// teamCount returns how many teams the game is split into. Callers must
+// hold g.Mu.
+func (g *Game) teamCount() int {
+	if g.Options.TeamCount < 2 {
+		return defaultTeamCount
+	}
+	return g.Options.TeamCount
+}
+
+// assignTeam puts player on the team with the fewest members. Callers must
+// hold g.Mu.
+func (g *Game) assignTeam(player *shared.Player) {
+	if !g.Options.TeamMode {
+		return
+	}
+	sizes := make([]int, g.teamCount()+1)
+	for _, p := range g.Players {
+		if p.Team > 0 && p.Team < len(sizes) {
+			sizes[p.Team]++
+		}
+	}
+	smallest := 1
+	for team := 2; team < len(sizes); team++ {
+		if sizes[team] < sizes[smallest] {
+			smallest = team
+		}
+	}
+	player.Team = smallest
+}
+
+// SwitchTeam moves a player to another team while the game is in the lobby.
+func (g *Game) SwitchTeam(playerID string, team int) error {
+	g.Mu.Lock()
+	if !g.Options.TeamMode {
+		g.Mu.Unlock()
+		return errors.New("this game isn't using teams")
+	}
+	if g.Status != NotStarted {
+		g.Mu.Unlock()
+		return errors.New("teams can only be changed in the lobby")
+	}
+	if team < 1 || team > g.teamCount() {
+		g.Mu.Unlock()
+		return errors.New("no such team")
+	}
+	player, exists := g.Players[playerID]
+	if !exists {
+		g.Mu.Unlock()
+		return errors.New("player not found")
+	}
+	player.Team = team
+	g.Mu.Unlock()
+
+	log.Printf("SwitchTeam: player %s moved to team %d", playerID, team)
+	g.BroadcastGameState()
+	return nil
+}
+
+// teammatesOnly reports whether only the drawer's team may guess. Callers
+// must hold g.Mu.
+func (g *Game) teammatesOnly() bool {
+	return g.Options.TeamMode && g.Options.TeamPlay == shared.TeamPlayTeammates
+}
+
+// canScoreGuess reports whether a correct guess from playerID earns points.
+// In teammates play only the drawer's team may guess. A drawer waiting out a
+// disconnect still has a team. Callers must hold g.Mu.
+func (g *Game) canScoreGuess(playerID string) bool {
+	if !g.teammatesOnly() {
+		return true
+	}
+	guesser, guesserOK := g.Players[playerID]
+	drawer, drawerOK := g.Players[g.CurrentTurn.CurrentDrawerID]
+	if !drawerOK {
+		drawer, drawerOK = g.TempDisconnectedPlayers[g.CurrentTurn.CurrentDrawerID]
+	}
+	return guesserOK && drawerOK && guesser.Team == drawer.Team
+}
+
+// skipTurnIfNobodyCanGuess skips turn when, in teammates play, the drawer has
+// no teammate connected to guess, instead of running out the clock. It
+// reports whether it did.
+func (g *Game) skipTurnIfNobodyCanGuess(turn *Turn) bool {
+	g.Mu.RLock()
+	stuck := g.Status == InProgress && g.CurrentTurn == turn &&
+		g.teammatesOnly() && len(g.eligibleGuessers()) == 0
+	g.Mu.RUnlock()
+	if stuck {
+		g.skipTurn(turn, "the drawer has no teammates to guess")
+	}
+	return stuck
+}
+
+// eligibleGuessers returns the players who can score this turn, which is
+// who has to guess before the turn ends early. Disconnected players don't
+// hold the turn up. Callers must hold g.Mu.
+func (g *Game) eligibleGuessers() []string {
+	guessers := make([]string, 0, len(g.PlayerOrder))
+	for _, id := range g.PlayerOrder {
+		if _, present := g.Players[id]; !present {
+			continue
+		}
+		if id != g.CurrentTurn.CurrentDrawerID && g.canScoreGuess(id) {
+			guessers = append(guessers, id)
+		}
+	}
+	return guessers
+}
+
+// creditTeam adds points to playerID's team, if they're on one. Callers must
+// hold g.Mu.
+func (g *Game) creditTeam(playerID string, points int) {
+	if !g.Options.TeamMode || points == 0 {
+		return
+	}
+	player, exists := g.Players[playerID]
+	if !exists || player.Team == 0 {
+		return
+	}
+	g.TeamScores[player.Team] += points
+
+	payload := map[string]any{
+		"team":  player.Team,
+		"score": g.TeamScores[player.Team],
+	}
+	if b, err := utils.CreateMessage("teamScoreUpdated", payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling teamScoreUpdated message:", err)
+	}
+}
+
+// teamStates returns each team's roster and score for the game state.
+// Callers must hold g.Mu.
+func (g *Game) teamStates() []TeamState {
+	if !g.Options.TeamMode {
+		return nil
+	}
+	teams := make([]TeamState, g.teamCount())
+	for i := range teams {
+		teams[i] = TeamState{ID: i + 1, Players: []string{}, Score: g.TeamScores[i+1]}
+	}
+	for _, id := range g.PlayerOrder {
+		if player, exists := g.Players[id]; exists && player.Team > 0 && player.Team <= len(teams) {
+			teams[player.Team-1].Players = append(teams[player.Team-1].Players, id)
+		}
+	}
+	return teams
+}
@@ -0,0 +1,66 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTeamAssignmentAndGuessers(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{TeamMode: true, TeamPlay: shared.TeamPlayTeammates}, "a", "b", "c", "d")
+
+	assert.Equal(t, 1, g.Players["a"].Team)
+	assert.Equal(t, 2, g.Players["b"].Team)
+	assert.Equal(t, 1, g.Players["c"].Team)
+	assert.Equal(t, 2, g.Players["d"].Team)
+
+	g.CurrentTurn = NewTurn("a")
+	assert.Equal(t, []string{"c"}, g.eligibleGuessers(), "only the drawer's teammates guess")
+
+	g.Options.TeamPlay = shared.TeamPlayRace
+	assert.Equal(t, []string{"b", "c", "d"}, g.eligibleGuessers())
+
+	g.creditTeam("d", 40)
+	g.creditTeam("b", 10)
+	teams := g.teamStates()
+	assert.Equal(t, []TeamState{
+		{ID: 1, Players: []string{"a", "c"}, Score: 0},
+		{ID: 2, Players: []string{"b", "d"}, Score: 50},
+	}, teams)
+
+	assert.NoError(t, g.SwitchTeam("a", 2))
+	assert.Equal(t, 2, g.Players["a"].Team)
+	assert.Error(t, g.SwitchTeam("a", 3))
+}
+
+func TestTeammatesPlay(t *testing.T) {
+	assert.Equal(t, shared.TeamPlayTeammates, normalizeOptions(shared.GameOptions{}).TeamPlay)
+	assert.Equal(t, shared.TeamPlayRace, normalizeOptions(shared.GameOptions{TeamPlay: " Race "}).TeamPlay)
+	assert.Equal(t, shared.TeamPlayTeammates, normalizeOptions(shared.GameOptions{TeamPlay: "bogus"}).TeamPlay)
+
+	g := newTestGame(t, shared.GameOptions{TeamMode: true}, "a", "b", "c", "d")
+	messenger := g.Messenger.(*stubMessenger)
+	g.Status = InProgress
+	g.Round.CurrentDrawerID = "a"
+	g.CurrentTurn = NewTurn("a")
+	g.CurrentTurn.Phase = PhaseDrawing
+	g.CurrentTurn.WordToGuess = &shared.Word{Word: "Butterfly"}
+	g.timers["turnTimer"] = NewTimer(g.ctx, "turnTimer", 60)
+
+	// The other team is told they can't guess rather than ignored
+	g.handlePlayerGuess("b", "butterfly")
+	assert.False(t, g.CurrentTurn.PlayersGuessedCorrectly["b"])
+	assert.Len(t, messenger.direct["b"], 1)
+
+	// A drawer waiting out a disconnect still has teammates
+	g.TempDisconnectedPlayers = map[string]*shared.Player{"a": g.Players["a"]}
+	delete(g.Players, "a")
+	assert.True(t, g.canScoreGuess("c"))
+	g.Players["a"] = g.TempDisconnectedPlayers["a"]
+	delete(g.TempDisconnectedPlayers, "a")
+
+	// With no teammate left to guess, the turn doesn't wait for the timer
+	g.HandleDisconnect("c")
+	assert.Equal(t, TurnEnded, <-g.FlowSignal)
+}
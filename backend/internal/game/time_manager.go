@@ -26,6 +26,7 @@ func (tm *TimerManager) StartGameCountdown(timerType string, duration int) {
 		tm.game.Mu.Lock()
 		tm.game.Status = InProgress
 		tm.game.Mu.Unlock()
+		tm.game.recordGameStart()
 		tm.game.Start()
 	}
 
@@ -66,10 +67,15 @@ func (tm *TimerManager) StartTurnTimer(playerID string) {
 
 	tm.game.Mu.Lock()
 	tm.game.timers["turnTimer"] = timer
+	if tm.game.Paused {
+		timer.Pause()
+	}
 	tm.game.Mu.Unlock()
 
+	// Cancelling only stops the clock. Whoever cancels a running turn is
+	// ending it and sends TurnEnded themselves, so it isn't ended twice.
 	onCancel := func() {
-		tm.game.FlowSignal <- TurnEnded
+		log.Println("Turn timer cancelled")
 	}
 	onFinish := func() {
 		tm.game.FlowSignal <- TurnEnded
@@ -91,6 +97,36 @@ func (tm *TimerManager) StartTurnTimer(playerID string) {
 	}()
 }
 
+// StartSummaryTimer counts down an intermission and calls onFinish when it
+// runs out. Cancelling it stops play from moving on.
+func (tm *TimerManager) StartSummaryTimer(timerType string, duration int, onFinish func()) {
+	tm.game.CancelTimer(timerType)
+	timer := NewTimer(tm.game.ctx, timerType, duration)
+
+	tm.game.Mu.Lock()
+	tm.game.timers[timerType] = timer
+	if tm.game.Paused {
+		timer.Pause()
+	}
+	tm.game.Mu.Unlock()
+
+	onCancel := func() {
+		log.Printf("%s cancelled", timerType)
+	}
+	go func() {
+		for remaining := range timer.StartCountdown(onFinish, onCancel) {
+			payload := map[string]interface{}{
+				"timeRemaining": remaining,
+			}
+			if b, err := utils.CreateMessage(timerType, payload); err == nil {
+				tm.game.Messenger.BroadcastMessage(b)
+			} else {
+				log.Printf("error marshalling %s message: %v", timerType, err)
+			}
+		}
+	}()
+}
+
 func (tm *TimerManager) StartWordSelectionTimer(playerID string) {
 	tm.game.CancelTimer("selectWordTimer")
 
@@ -109,6 +145,9 @@ func (tm *TimerManager) StartWordSelectionTimer(playerID string) {
 
 	tm.game.Mu.Lock()
 	tm.game.timers["selectWordTimer"] = timer
+	if tm.game.Paused {
+		timer.Pause()
+	}
 	tm.game.Mu.Unlock()
 
 	timerID := timer // Store a reference to this specific timer instance
@@ -144,3 +183,30 @@ func (tm *TimerManager) StartWordSelectionTimer(playerID string) {
 		log.Println("Word selection timer ended.")
 	}()
 }
+
+// turnTimerTypes are the timers a paused game freezes.
+var turnTimerTypes = []string{"turnTimer", "selectWordTimer", "turnSummaryTimer", "roundSummaryTimer"}
+
+// PauseTimers freezes the running turn timers. Callers must hold g.Mu.
+func (tm *TimerManager) PauseTimers() {
+	for _, timerType := range turnTimerTypes {
+		if timer, exists := tm.game.timers[timerType]; exists {
+			timer.Pause()
+		}
+	}
+}
+
+// ResumeTimers restarts the turn timers paused by PauseTimers. The word
+// choice stays on hold while its drawer is away; HandleReconnect resumes it.
+// Callers must hold g.Mu.
+func (tm *TimerManager) ResumeTimers() {
+	_, drawerAway := tm.game.TempDisconnectedPlayers[tm.game.CurrentTurn.CurrentDrawerID]
+	for _, timerType := range turnTimerTypes {
+		if timerType == "selectWordTimer" && drawerAway {
+			continue
+		}
+		if timer, exists := tm.game.timers[timerType]; exists {
+			timer.Resume()
+		}
+	}
+}
@@ -12,6 +12,7 @@ type Timer struct {
 	duration  int
 	remaining int
 	isRunning bool
+	paused    bool
 	mu        sync.RWMutex
 	ctx       context.Context // Store the context
 	cancel    context.CancelFunc
@@ -52,6 +53,10 @@ func (t *Timer) StartCountdown(onFinish func(), onCancel func()) <-chan int {
 			select {
 			case <-ticker.C:
 				t.mu.Lock()
+				if t.paused {
+					t.mu.Unlock()
+					continue
+				}
 				if t.remaining <= 0 {
 					t.isRunning = false
 					t.mu.Unlock()
@@ -108,9 +113,30 @@ func (t *Timer) Cancel() {
 	}
 }
 
+// Pause freezes the countdown at its current remaining time.
+func (t *Timer) Pause() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.paused = true
+}
+
+// Resume continues a paused countdown from where it stopped.
+func (t *Timer) Resume() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.paused = false
+}
+
+// Remaining returns the seconds left on the countdown.
+func (t *Timer) Remaining() int {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.remaining
+}
+
 func (g *Game) GetRemainingTime(timerType string) int {
 	if timer, exists := g.timers[timerType]; exists {
-		return timer.remaining
+		return timer.Remaining()
 	}
 	return 0
 }
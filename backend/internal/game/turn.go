@@ -2,8 +2,9 @@ package game
 
 import (
 	"log"
-	"math/rand"
+	"time"
 
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
 	"github.com/Ajstraight619/pictionary-server/internal/utils"
 )
@@ -13,18 +14,30 @@ type TurnPhase int
 const (
 	PhaseWordSelection TurnPhase = iota
 	PhaseDrawing
+	PhaseTurnSummary  // The word is revealed and the turn's points shown
+	PhaseRoundSummary // Standings are shown before the next round
 )
 
 type Turn struct {
-	CurrentDrawerID         string          `json:"currentDrawerID"`
-	WordToGuess             *shared.Word    `json:"wordToGuess,omitempty"`
-	RevealedLetters         []rune          `json:"revealedLetters"`
-	PlayersGuessedCorrectly map[string]bool `json:"playersGuessedCorrectly"`
-	Phase                   TurnPhase       `json:"phase"`
-	IsSelectingWord         bool            `json:"isSelectingWord"`
-	SelectableWords         []shared.Word   `json:"selectableWords,omitempty"`
-	revealedCount           int             `json:"-"`
-	unrevealedIndices       []int           `json:"-"`
+	CurrentDrawerID         string            `json:"currentDrawerID"`
+	WordToGuess             *shared.Word      `json:"wordToGuess,omitempty"`
+	RevealedLetters         []rune            `json:"revealedLetters"`
+	PlayersGuessedCorrectly map[string]bool   `json:"playersGuessedCorrectly"`
+	Phase                   TurnPhase         `json:"phase"`
+	IsSelectingWord         bool              `json:"isSelectingWord"`
+	SelectableWords         []shared.Word     `json:"selectableWords,omitempty"`
+	ScoreBreakdown          []ScoreEntry      `json:"scoreBreakdown"`
+	guessedChat             []ChatLine        `json:"-"`
+	canvas                  []e.CanvasOp      `json:"-"`
+	undone                  []e.CanvasOp      `json:"-"`
+	timeline                []e.TimelineEntry `json:"-"`
+	guesses                 []e.WordGuesser   `json:"-"`
+	drawingStartedAt        time.Time         `json:"-"`
+	pausedAt                time.Time         `json:"-"`
+	pausedFor               time.Duration     `json:"-"`
+	revealedCount           int               `json:"-"`
+	unrevealedIndices       []int             `json:"-"`
+	skipped                 bool              `json:"-"` // skipTurn has already ended it
 }
 
 func InitTurn() *Turn {
@@ -59,20 +72,13 @@ func (t *Turn) Start(g *Game, playerID string) {
 	for i := range t.RevealedLetters {
 		t.RevealedLetters[i] = '_'
 	}
-	// prepare indices for reveal (skip spaces)
-	t.unrevealedIndices = make([]int, 0, len(letters))
-	for i, r := range letters {
-		if r != ' ' {
-			t.unrevealedIndices = append(t.unrevealedIndices, i)
-		}
-	}
-	// shuffle reveal order
-	rand.Shuffle(len(t.unrevealedIndices), func(i, j int) {
-		t.unrevealedIndices[i], t.unrevealedIndices[j] = t.unrevealedIndices[j], t.unrevealedIndices[i]
-	})
+	// prepare reveal order for the game's hint strategy
+	t.unrevealedIndices = hintOrder(g.Options.Hints, letters)
 	// reset counter
 	// set drawer and start timer
 	t.CurrentDrawerID = playerID
+	t.drawingStartedAt = time.Now()
+	g.sendWordHint(t)
 	g.Mu.Unlock()
 	g.TimerManager.StartTurnTimer(playerID)
 	log.Printf("Current revealed letters on turn start: %s", string(t.RevealedLetters))
@@ -87,22 +93,7 @@ func (t *Turn) BroadcastRevealedLetter(g *Game, timeRemaining int) {
 	}
 
 	letters := []rune(t.WordToGuess.Word)
-	totalLetters := len(letters)
-	turnLimit := g.Options.TurnTimeLimit
-
-	// don't reveal short words
-	if totalLetters <= 3 {
-		return
-	}
-
-	elapsed := turnLimit - timeRemaining
-	maxReveal := int(float64(totalLetters) * 0.7)
-	if maxReveal <= 0 {
-		return
-	}
-
-	// determine how many letters should be revealed by now (linear)
-	allowed := min(elapsed*maxReveal/turnLimit, maxReveal)
+	allowed := hintsAllowed(g.Options, len(letters), len(t.unrevealedIndices), timeRemaining)
 
 	// reveal any new letters up to allowed
 	for t.revealedCount < allowed && t.revealedCount < len(t.unrevealedIndices) {
@@ -121,35 +112,43 @@ func (t *Turn) BroadcastRevealedLetter(g *Game, timeRemaining int) {
 	}
 }
 
+// End scores and records the turn and starts its summary. The turn is claimed
+// under the lock first, so a second TurnEnded for it does nothing.
 func (t *Turn) End(g *Game) {
+	g.Mu.Lock()
+	if t.Phase >= PhaseTurnSummary {
+		g.Mu.Unlock()
+		return
+	}
+	t.Phase = PhaseTurnSummary
+	g.Mu.Unlock()
+
 	log.Println("Turn ended")
 	g.CancelTimer("turnTimer")
+	g.recordTurnDrawing(t)
 	g.ClearDrawingPlayers()
+	g.Mu.Lock()
 	g.Round.MarkPlayerAsDrawn(t.CurrentDrawerID)
-	t.Phase = PhaseWordSelection
 	t.RevealedLetters = nil
 	t.revealedCount = 0
-	g.Mu.Lock()
-	roundComplete := len(g.Round.PlayersDrawn) == len(g.PlayerOrder)
+	t.timeline = nil
+	t.guessedChat = nil
+	g.summarizeTurn(t)
 	g.Mu.Unlock()
-	g.setWord(nil)
-	g.BroadcastGameState()
-	if roundComplete {
-		log.Println("Round is over signalling round ended ")
-		g.FlowSignal <- RoundEnded
-	} else {
-		g.Round.NextDrawer(g)
-		g.FlowSignal <- TurnStarted
-	}
+	g.startTurnSummary(t)
 }
 
-func getCurrentDrawer(g *Game) *shared.Player {
-	return g.Players[g.PlayerOrder[g.Round.CurrentDrawerIdx]]
+// guessLatency is how long the drawing has been up, leaving out any time the
+// game spent paused.
+func (t *Turn) guessLatency() time.Duration {
+	return time.Since(t.drawingStartedAt) - t.pausedFor
 }
 
-func (t *Turn) allGuessedCorrectly() bool {
-	for _, guessedCorrectly := range t.PlayersGuessedCorrectly {
-		if !guessedCorrectly {
+// allGuessedCorrectly reports whether every guesser other than the drawer
+// has guessed the word.
+func (t *Turn) allGuessedCorrectly(guessers []string) bool {
+	for _, id := range guessers {
+		if id != t.CurrentDrawerID && !t.PlayersGuessedCorrectly[id] {
 			return false
 		}
 	}
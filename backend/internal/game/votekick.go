@@ -0,0 +1,227 @@
+package game
+
+import (
+	"errors"
+	"log"
+	"math"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
+)
+
+const (
+	defaultVoteKickTime = 30
+	// minVoteKickPlayers keeps two players from kicking each other.
+	minVoteKickPlayers = 3
+)
+
+// voteKick is a running vote to remove a player.
+type voteKick struct {
+	targetID  string
+	startedBy string
+	votes     map[string]bool
+	needed    int
+	endsAt    time.Time
+	timer     *time.Timer
+}
+
+// VoteKickState is what clients see of a running kick vote.
+type VoteKickState struct {
+	TargetID  string    `json:"targetID"`
+	StartedBy string    `json:"startedBy"`
+	Yes       int       `json:"yes"`
+	No        int       `json:"no"`
+	Needed    int       `json:"needed"`
+	EndsAt    time.Time `json:"endsAt"`
+}
+
+// VoteKick starts a vote to kick targetID, or casts voterID's vote in the
+// running one. Any player can start a vote; the target is kicked once enough
+// connected players agree.
+func (g *Game) VoteKick(voterID, targetID string, approve bool) error {
+	g.Mu.Lock()
+	voter, isPlayer := g.Players[voterID]
+	if !isPlayer {
+		g.Mu.Unlock()
+		return errors.New("only players can vote to kick")
+	}
+
+	vote := g.voteKick
+	if vote == nil {
+		if !approve {
+			g.Mu.Unlock()
+			return nil
+		}
+		if _, exists := g.Players[targetID]; !exists {
+			g.Mu.Unlock()
+			return errors.New("player not found")
+		}
+		if targetID == voterID {
+			g.Mu.Unlock()
+			return errors.New("you can't vote to kick yourself")
+		}
+		voters := g.voteKickVoters(targetID)
+		if len(voters)+1 < minVoteKickPlayers {
+			g.Mu.Unlock()
+			return errors.New("not enough players to hold a vote")
+		}
+
+		duration := g.Options.VoteKickTime
+		if duration <= 0 {
+			duration = defaultVoteKickTime
+		}
+		vote = &voteKick{
+			targetID:  targetID,
+			startedBy: voterID,
+			votes:     make(map[string]bool),
+			needed:    votesNeeded(len(voters), g.Options.VoteKickMajority),
+			endsAt:    time.Now().Add(time.Duration(duration) * time.Second),
+		}
+		vote.timer = time.AfterFunc(time.Duration(duration)*time.Second, func() {
+			g.expireVoteKick(vote)
+		})
+		g.voteKick = vote
+		log.Printf("VoteKick: player %s started a vote to kick %s", voterID, targetID)
+	} else if vote.targetID != targetID {
+		g.Mu.Unlock()
+		return errors.New("another kick vote is already running")
+	}
+
+	if voterID == vote.targetID {
+		g.Mu.Unlock()
+		return errors.New("you can't vote on your own kick")
+	}
+
+	if !approve && voter.IsHost && g.Options.VoteKickHostVeto {
+		g.endVoteKick(vote, false, "vetoed by the host")
+		g.Mu.Unlock()
+		g.BroadcastGameState()
+		return nil
+	}
+
+	vote.votes[voterID] = approve
+	yes, no := g.tallyVoteKick(vote)
+	remaining := len(g.voteKickVoters(vote.targetID)) - yes - no
+	kicked := yes >= vote.needed
+	failed := !kicked && yes+remaining < vote.needed
+
+	switch {
+	case kicked:
+		g.endVoteKick(vote, true, "the vote passed")
+	case failed:
+		g.endVoteKick(vote, false, "not enough votes")
+	default:
+		g.broadcastVoteKick()
+	}
+	g.Mu.Unlock()
+
+	if kicked {
+		g.kickPlayer(vote.targetID)
+	} else if failed {
+		g.BroadcastGameState()
+	}
+	return nil
+}
+
+// votesNeeded is how many of voters must agree. majority is a share of the
+// voters; anything outside (0, 1] means more than half.
+func votesNeeded(voters int, majority float64) int {
+	if majority <= 0 || majority > 1 {
+		return voters/2 + 1
+	}
+	return max(1, int(math.Ceil(float64(voters)*majority)))
+}
+
+// voteKickVoters returns the connected players who can vote on kicking
+// targetID. Callers must hold g.Mu.
+func (g *Game) voteKickVoters(targetID string) []string {
+	voters := make([]string, 0, len(g.Players))
+	for id, player := range g.Players {
+		if id != targetID && player.Connected {
+			voters = append(voters, id)
+		}
+	}
+	return voters
+}
+
+// tallyVoteKick counts votes from players who can still vote. Callers must
+// hold g.Mu.
+func (g *Game) tallyVoteKick(vote *voteKick) (yes, no int) {
+	for _, id := range g.voteKickVoters(vote.targetID) {
+		approve, voted := vote.votes[id]
+		switch {
+		case !voted:
+		case approve:
+			yes++
+		default:
+			no++
+		}
+	}
+	return yes, no
+}
+
+// voteKickState returns the running vote for clients, or nil. Callers must
+// hold g.Mu.
+func (g *Game) voteKickState() *VoteKickState {
+	vote := g.voteKick
+	if vote == nil {
+		return nil
+	}
+	yes, no := g.tallyVoteKick(vote)
+	return &VoteKickState{
+		TargetID:  vote.targetID,
+		StartedBy: vote.startedBy,
+		Yes:       yes,
+		No:        no,
+		Needed:    vote.needed,
+		EndsAt:    vote.endsAt,
+	}
+}
+
+// broadcastVoteKick sends the running vote's progress. Callers must hold
+// g.Mu.
+func (g *Game) broadcastVoteKick() {
+	if b, err := utils.CreateMessage("voteKickUpdated", g.voteKickState()); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling voteKickUpdated message:", err)
+	}
+}
+
+// endVoteKick closes the vote and announces the result. Callers must hold
+// g.Mu.
+func (g *Game) endVoteKick(vote *voteKick, kicked bool, reason string) {
+	vote.timer.Stop()
+	g.voteKick = nil
+	log.Printf("VoteKick: vote to kick %s ended (kicked: %v): %s", vote.targetID, kicked, reason)
+
+	payload := map[string]any{
+		"targetID": vote.targetID,
+		"kicked":   kicked,
+		"reason":   reason,
+	}
+	if b, err := utils.CreateMessage("voteKickEnded", payload); err == nil {
+		g.Messenger.BroadcastMessage(b)
+	} else {
+		log.Println("error marshalling voteKickEnded message:", err)
+	}
+}
+
+// endVoteKickOn ends the running vote if targetID is its target, for when
+// the target leaves or is removed some other way. Callers must hold g.Mu.
+func (g *Game) endVoteKickOn(targetID, reason string) {
+	if vote := g.voteKick; vote != nil && vote.targetID == targetID {
+		g.endVoteKick(vote, false, reason)
+	}
+}
+
+func (g *Game) expireVoteKick(vote *voteKick) {
+	g.Mu.Lock()
+	if g.voteKick != vote {
+		g.Mu.Unlock()
+		return
+	}
+	g.endVoteKick(vote, false, "time ran out")
+	g.Mu.Unlock()
+	g.BroadcastGameState()
+}
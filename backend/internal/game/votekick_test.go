@@ -0,0 +1,61 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVoteKickPasses(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host", "a", "b", "troll")
+
+	require.NoError(t, g.VoteKick("a", "troll", true))
+	require.NotNil(t, g.voteKickState())
+	assert.Equal(t, 2, g.voteKickState().Needed, "more than half of three voters")
+	assert.Error(t, g.VoteKick("troll", "troll", false), "the target can't vote")
+	assert.Error(t, g.VoteKick("b", "host", true), "one vote at a time")
+
+	require.NoError(t, g.VoteKick("b", "troll", true))
+	assert.Nil(t, g.voteKickState())
+	assert.NotContains(t, g.Players, "troll")
+	assert.Contains(t, g.RemovedPlayers, "troll")
+}
+
+func TestVoteKickFailsAndVeto(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{VoteKickMajority: 1}, "host", "a", "b")
+
+	require.NoError(t, g.VoteKick("a", "b", true))
+	require.NoError(t, g.VoteKick("host", "b", false))
+	assert.Nil(t, g.voteKickState(), "a unanimous vote can't pass after a no")
+	assert.Contains(t, g.Players, "b")
+
+	g.Options.VoteKickHostVeto = true
+	g.Options.VoteKickMajority = 0
+	require.NoError(t, g.VoteKick("a", "b", true))
+	require.NoError(t, g.VoteKick("host", "b", false))
+	assert.Nil(t, g.voteKickState())
+	assert.Contains(t, g.Players, "b")
+
+	assert.Error(t, newTestGame(t, shared.GameOptions{}, "x", "y").VoteKick("x", "y", true), "too few players")
+}
+
+func TestVoteKickEndsWhenTargetGoes(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{}, "host", "a", "b", "c", "troll")
+
+	require.NoError(t, g.VoteKick("a", "troll", true))
+	require.NoError(t, g.RemovePlayerByHost("troll", "host"))
+	assert.Nil(t, g.voteKickState(), "the vote ends with its target")
+
+	require.NoError(t, g.VoteKick("a", "c", true), "a new vote can start right away")
+	g.RemovePlayer("c")
+	assert.Nil(t, g.voteKickState())
+}
+
+func TestVotesNeeded(t *testing.T) {
+	assert.Equal(t, 2, votesNeeded(3, 0))
+	assert.Equal(t, 3, votesNeeded(4, 0))
+	assert.Equal(t, 3, votesNeeded(4, 0.66))
+	assert.Equal(t, 4, votesNeeded(4, 1))
+}
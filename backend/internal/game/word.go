@@ -3,6 +3,8 @@ package game
 import (
 	"log"
 	"math/rand"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/Ajstraight619/pictionary-server/internal/db"
@@ -14,9 +16,16 @@ type WordSelector struct {
 	game *Game
 }
 
+var difficultyMultipliers = map[string]float64{
+	shared.DifficultyEasy:   0.75,
+	shared.DifficultyMedium: 1,
+	shared.DifficultyHard:   1.5,
+}
+
 type WordSelectionPayload struct {
-	IsSelectingWord bool          `json:"isSelectingWord"`
-	SelectableWords []shared.Word `json:"selectableWords"`
+	IsSelectingWord bool               `json:"isSelectingWord"`
+	SelectableWords []shared.Word      `json:"selectableWords"`
+	Multipliers     map[string]float64 `json:"multipliers"`
 }
 
 func NewWordSelector(game *Game) *WordSelector {
@@ -33,6 +42,7 @@ func (ws *WordSelector) SelectWord() {
 	selectionPayload := WordSelectionPayload{
 		IsSelectingWord: true,
 		SelectableWords: ws.game.CurrentTurn.SelectableWords,
+		Multipliers:     difficultyMultipliers,
 	}
 
 	b, err := utils.CreateMessage("openSelectWordModal", selectionPayload)
@@ -40,7 +50,7 @@ func (ws *WordSelector) SelectWord() {
 		log.Println("error marshalling message:", err)
 		return
 	}
-	currentDrawer := ws.game.Round.GetCurrentDrawer(ws.game.Players, ws.game.PlayerOrder)
+	currentDrawer := ws.game.Round.GetCurrentDrawer(ws.game.Players)
 	if currentDrawer == nil {
 		log.Println("No current drawer found.")
 		return
@@ -54,6 +64,25 @@ func (g *Game) setWord(word *shared.Word) {
 	g.Mu.Lock()
 	defer g.Mu.Unlock()
 	g.CurrentTurn.WordToGuess = word
+	if word != nil {
+		g.UsedWords = append(g.UsedWords, *word)
+	}
+}
+
+// selectableWord returns the offered word matching choice, if playerID is
+// the drawer and is still choosing, and the game isn't paused.
+func (g *Game) selectableWord(playerID string, choice shared.Word) (shared.Word, bool) {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	if g.Paused || !g.CurrentTurn.IsSelectingWord || g.Round.CurrentDrawerID != playerID {
+		return shared.Word{}, false
+	}
+	for _, w := range g.CurrentTurn.SelectableWords {
+		if w.Id == choice.Id && w.Word == choice.Word {
+			return w, true
+		}
+	}
+	return shared.Word{}, false
 }
 
 func (g *Game) setIsSelectingWord(selecting bool) {
@@ -63,16 +92,127 @@ func (g *Game) setIsSelectingWord(selecting bool) {
 }
 
 func (g *Game) setRandomWords(n int) error {
-	g.Mu.Lock()
-	defer g.Mu.Unlock()
-	words, err := db.GetRandomWords(n)
+	g.Mu.RLock()
+	options := g.Options
+	used := make([]string, 0, len(g.UsedWords))
+	for _, w := range g.UsedWords {
+		used = append(used, w.Word)
+	}
+	g.Mu.RUnlock()
+
+	words, err := pickWords(n, options, used)
 	if err != nil {
 		return err
 	}
+	// Every allowed word has been played; repeats beat an empty choice.
+	if len(words) == 0 && len(used) > 0 {
+		log.Println("setRandomWords: no unused words left, allowing repeats")
+		if words, err = pickWords(n, options, nil); err != nil {
+			return err
+		}
+	}
+
+	g.Mu.Lock()
+	defer g.Mu.Unlock()
 	g.CurrentTurn.SelectableWords = words
 	return nil
 }
 
+// pickWords chooses up to n words the game hasn't used yet. Custom words
+// either replace the default words or are mixed in about evenly with them.
+func pickWords(n int, options shared.GameOptions, used []string) ([]shared.Word, error) {
+	usedSet := make(map[string]bool, len(used))
+	for _, w := range used {
+		usedSet[strings.ToLower(w)] = true
+	}
+
+	// Over-fetch so there's a word from each difficulty tier to offer.
+	candidateCount := n * len(shared.Difficulties)
+
+	var pool []shared.Word
+	if !options.CustomWordsOnly || len(options.CustomWords) == 0 {
+		words, err := db.GetRandomWordsExcluding(candidateCount, options.Categories, used)
+		if err != nil {
+			return nil, err
+		}
+		pool = append(pool, words...)
+	}
+
+	custom := make([]shared.Word, 0, len(options.CustomWords))
+	for _, w := range options.CustomWords {
+		if !usedSet[strings.ToLower(w)] {
+			custom = append(custom, shared.Word{Word: w, Category: customWordCategory, Difficulty: shared.DifficultyMedium})
+		}
+	}
+	rand.Shuffle(len(custom), func(i, j int) { custom[i], custom[j] = custom[j], custom[i] })
+	pool = append(pool, custom[:min(candidateCount, len(custom))]...)
+	rand.Shuffle(len(pool), func(i, j int) { pool[i], pool[j] = pool[j], pool[i] })
+
+	// A custom word can duplicate a default one; offer it only once.
+	seen := make(map[string]bool, len(pool))
+	candidates := make([]shared.Word, 0, len(pool))
+	for _, w := range pool {
+		key := strings.ToLower(w.Word)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		candidates = append(candidates, w)
+	}
+	return spreadDifficulties(candidates, n), nil
+}
+
+// spreadDifficulties picks n words covering as many tiers as it can, so the
+// drawer can trade difficulty for points, and orders them easiest first.
+func spreadDifficulties(candidates []shared.Word, n int) []shared.Word {
+	picked := make([]bool, len(candidates))
+	words := make([]shared.Word, 0, n)
+	for _, tier := range shared.Difficulties {
+		if len(words) == n {
+			break
+		}
+		for i, w := range candidates {
+			if !picked[i] && w.Difficulty == tier {
+				picked[i] = true
+				words = append(words, w)
+				break
+			}
+		}
+	}
+	for i, w := range candidates {
+		if len(words) == n {
+			break
+		}
+		if !picked[i] {
+			picked[i] = true
+			words = append(words, w)
+		}
+	}
+	slices.SortStableFunc(words, func(a, b shared.Word) int {
+		return tierRank(a.Difficulty) - tierRank(b.Difficulty)
+	})
+	return words
+}
+
+func tierRank(difficulty string) int {
+	if i := slices.Index(shared.Difficulties, difficulty); i >= 0 {
+		return i
+	}
+	return slices.Index(shared.Difficulties, shared.DifficultyMedium)
+}
+
+// difficultyMultiplier scales the points a word is worth. Medium words
+// score the same as every word did before tiers existed.
+func difficultyMultiplier(word *shared.Word) float64 {
+	if word == nil {
+		return 1
+	}
+	if m, ok := difficultyMultipliers[word.Difficulty]; ok {
+		return m
+	}
+	return 1
+}
+
 func (g *Game) clearSelectableWords() {
 	g.Mu.Lock()
 	defer g.Mu.Unlock()
@@ -101,8 +241,14 @@ func (g *Game) handleTimerExpiration() {
 			log.Println("error marshalling selectedWord message:", err)
 			return
 		}
-		currentDrawer := g.Round.GetCurrentDrawer(g.Players, g.PlayerOrder)
-		g.Messenger.SendToPlayer(currentDrawer.ID, b)
+		// The drawer may have dropped while the timer ran out; their turn is
+		// skipped if they don't come back
+		g.Mu.RLock()
+		currentDrawer := g.Round.GetCurrentDrawer(g.Players)
+		g.Mu.RUnlock()
+		if currentDrawer != nil {
+			g.Messenger.SendToPlayer(currentDrawer.ID, b)
+		}
 		g.BroadcastGameState()
 		time.AfterFunc(1*time.Second, func() {
 			g.FlowSignal <- TurnStarted
@@ -0,0 +1,60 @@
+package game
+
+import (
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPickCustomWordsWithoutRepeats(t *testing.T) {
+	options := normalizeOptions(shared.GameOptions{
+		CustomWords:     []string{" Rocket ", "rocket", "Office  Chair", "Stapler", ""},
+		CustomWordsOnly: true,
+	})
+	assert.Equal(t, []string{"Rocket", "Office Chair", "Stapler"}, options.CustomWords)
+
+	words, err := pickWords(3, options, []string{"ROCKET"})
+	require.NoError(t, err)
+	assert.Len(t, words, 2)
+	for _, w := range words {
+		assert.NotEqual(t, "Rocket", w.Word)
+		assert.Equal(t, customWordCategory, w.Category)
+		assert.Equal(t, shared.DifficultyMedium, w.Difficulty)
+	}
+
+	words, err = pickWords(3, options, []string{"Rocket", "Office Chair", "Stapler"})
+	require.NoError(t, err)
+	assert.Empty(t, words)
+}
+
+func TestSpreadDifficulties(t *testing.T) {
+	candidates := []shared.Word{
+		{Word: "a", Difficulty: shared.DifficultyHard},
+		{Word: "b", Difficulty: shared.DifficultyMedium},
+		{Word: "c", Difficulty: shared.DifficultyMedium},
+		{Word: "d", Difficulty: shared.DifficultyEasy},
+	}
+	words := spreadDifficulties(candidates, 3)
+	assert.Equal(t, []string{"d", "b", "a"}, []string{words[0].Word, words[1].Word, words[2].Word})
+
+	words = spreadDifficulties(candidates[1:3], 3)
+	assert.Len(t, words, 2)
+}
+
+func TestWordListsOnlyReachTheHost(t *testing.T) {
+	g := newTestGame(t, shared.GameOptions{
+		CustomWords:  []string{"Rocket"},
+		BlockedWords: []string{"darn"},
+	}, "host", "guest")
+
+	state := g.GetGameState()
+	assert.Empty(t, state.Options.CustomWords)
+	assert.Empty(t, state.Options.BlockedWords)
+	assert.Empty(t, g.gameStateFor("guest").Options.CustomWords)
+
+	state = g.gameStateFor("host")
+	assert.Equal(t, []string{"Rocket"}, state.Options.CustomWords)
+	assert.Equal(t, []string{"darn"}, state.Options.BlockedWords)
+}
@@ -0,0 +1,111 @@
+package handlers
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/db"
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	g "github.com/Ajstraight619/pictionary-server/internal/game"
+	"github.com/Ajstraight619/pictionary-server/internal/server"
+	"github.com/labstack/echo/v4"
+)
+
+type DrawingResponse struct {
+	ID        uint              `json:"id"`
+	GameID    string            `json:"gameID"`
+	DrawerID  string            `json:"drawerID"`
+	Round     int               `json:"round"`
+	Word      string            `json:"word"`
+	Category  string            `json:"category"`
+	StartedAt time.Time         `json:"startedAt"`
+	EndedAt   time.Time         `json:"endedAt"`
+	Timeline  []e.TimelineEntry `json:"timeline,omitempty"`
+}
+
+func newDrawingResponse(d db.TurnDrawing) DrawingResponse {
+	return DrawingResponse{
+		ID:        d.ID,
+		GameID:    d.GameID,
+		DrawerID:  d.DrawerID,
+		Round:     d.Round,
+		Word:      d.Word,
+		Category:  d.Category,
+		StartedAt: d.StartedAt,
+		EndedAt:   d.EndedAt,
+	}
+}
+
+// ListDrawingsHandler returns a summary of every recorded drawing in a game
+func ListDrawingsHandler(c echo.Context, gameServer *server.GameServer) error {
+	gameID, ok := gameServer.ResolveGameID(c.Param("id"))
+	if !ok {
+		return c.JSON(http.StatusNotFound, map[string]string{"error": "Game not found"})
+	}
+
+	drawings, err := db.GetTurnDrawings(gameID)
+	if err != nil {
+		log.Printf("ListDrawingsHandler: %v", err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load drawings"})
+	}
+
+	resp := make([]DrawingResponse, 0, len(drawings))
+	for _, d := range drawings {
+		resp = append(resp, newDrawingResponse(d))
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetDrawingHandler returns a drawing with its full timeline for replay
+func GetDrawingHandler(c echo.Context, gameServer *server.GameServer) error {
+	drawing, ok, err := getDrawing(c, gameServer)
+	if !ok {
+		return err
+	}
+
+	resp := newDrawingResponse(*drawing)
+	if err := json.Unmarshal([]byte(drawing.Timeline), &resp.Timeline); err != nil {
+		log.Printf("GetDrawingHandler: error decoding timeline for drawing %d: %v", drawing.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load drawing"})
+	}
+	return c.JSON(http.StatusOK, resp)
+}
+
+// GetDrawingSVGHandler renders the final canvas of a drawing as SVG
+func GetDrawingSVGHandler(c echo.Context, gameServer *server.GameServer) error {
+	drawing, ok, err := getDrawing(c, gameServer)
+	if !ok {
+		return err
+	}
+
+	var ops []e.CanvasOp
+	if err := json.Unmarshal([]byte(drawing.Canvas), &ops); err != nil {
+		log.Printf("GetDrawingSVGHandler: error decoding canvas for drawing %d: %v", drawing.ID, err)
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to render drawing"})
+	}
+	return c.Blob(http.StatusOK, "image/svg+xml", []byte(g.RenderCanvasSVG(ops)))
+}
+
+// getDrawing loads the drawing named in the route. When it can't, it writes
+// the error response itself and returns ok=false along with any error from
+// writing it. The game can be given by ID or, while it's still running, by
+// room code.
+func getDrawing(c echo.Context, gameServer *server.GameServer) (drawing *db.TurnDrawing, ok bool, err error) {
+	drawingID, err := strconv.ParseUint(c.Param("drawingID"), 10, 64)
+	if err != nil {
+		return nil, false, c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid drawing ID"})
+	}
+	gameID, found := gameServer.ResolveGameID(c.Param("id"))
+	if !found {
+		return nil, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Game not found"})
+	}
+
+	drawing, err = db.GetTurnDrawing(gameID, uint(drawingID))
+	if err != nil {
+		return nil, false, c.JSON(http.StatusNotFound, map[string]string{"error": "Drawing not found"})
+	}
+	return drawing, true, nil
+}
@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/server"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+)
+
+func TestGetDrawingErrors(t *testing.T) {
+	gameServer := server.NewGameServer(zap.NewNop())
+
+	cases := []struct {
+		name      string
+		gameID    string
+		drawingID string
+		status    int
+	}{
+		{"bad drawing ID", uuid.New().String(), "abc", http.StatusBadRequest},
+		{"unknown game", "NOSUCH", "1", http.StatusNotFound},
+		{"missing drawing", uuid.New().String(), "1", http.StatusNotFound},
+	}
+	for _, tc := range cases {
+		for _, handler := range []func(echo.Context, *server.GameServer) error{GetDrawingHandler, GetDrawingSVGHandler} {
+			rec := httptest.NewRecorder()
+			c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+			c.SetParamNames("id", "drawingID")
+			c.SetParamValues(tc.gameID, tc.drawingID)
+
+			assert.NotPanics(t, func() { assert.NoError(t, handler(c, gameServer)) }, tc.name)
+			assert.Equal(t, tc.status, rec.Code, tc.name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 
+	"github.com/Ajstraight619/pictionary-server/internal/db"
 	g "github.com/Ajstraight619/pictionary-server/internal/game"
 	"github.com/Ajstraight619/pictionary-server/internal/server"
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
@@ -19,6 +20,7 @@ type CreateGameRequest struct {
 type JoinGameRequest struct {
 	Username string `json:"username"`
 	GameID   string `json:"gameID"`
+	Spectate bool   `json:"spectate"`
 }
 
 var numPlayers = 2
@@ -70,6 +72,7 @@ func CreateGameHandler(c echo.Context, server *server.GameServer) error {
 
 	return c.JSON(http.StatusOK, map[string]string{
 		"gameID":   gameID,
+		"code":     game.Code,
 		"playerID": playerID,
 	})
 }
@@ -84,6 +87,8 @@ func JoinGameHandler(c echo.Context, server *server.GameServer) error {
 	if !exists {
 		return c.JSON(http.StatusNotFound, map[string]string{"error": "Game not found"})
 	}
+	// Players may join with the room code; sessions always use the ID
+	req.GameID = game.ID
 
 	// Get player info from session or query params
 	playerID, _, _ := GetPlayerIDFromSession(c, req.GameID)
@@ -107,15 +112,20 @@ func JoinGameHandler(c echo.Context, server *server.GameServer) error {
 		}
 	}
 
-	// If game is in progress and not reconnecting, reject
-	if game.Status == g.InProgress {
-		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Game is already in progress"})
-	}
-
 	if req.Username == "" {
 		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Username is required"})
 	}
 
+	// Spectators can join at any point since they never take a turn
+	if req.Spectate {
+		return joinAsSpectator(c, game, req)
+	}
+
+	// If game is in progress and not reconnecting, wait for the next round
+	if game.Status == g.InProgress {
+		return joinQueue(c, game, req)
+	}
+
 	// Generate a fresh player ID to avoid collision with removed players
 	newPlayerID := uuid.New().String()
 	player := game.NewPlayer(newPlayerID, req.Username, false)
@@ -130,3 +140,68 @@ func JoinGameHandler(c echo.Context, server *server.GameServer) error {
 		"playerID": newPlayerID,
 	})
 }
+
+func joinAsSpectator(c echo.Context, game *g.Game, req JoinGameRequest) error {
+	spectatorID := uuid.New().String()
+	spectator := game.NewPlayer(spectatorID, req.Username, false)
+	spectator.Pending = true
+	if err := game.AddSpectator(spectator); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	UpdateSessionWithNewPlayer(c, spectatorID, req.Username, req.GameID)
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"gameID":    req.GameID,
+		"playerID":  spectatorID,
+		"spectator": true,
+	})
+}
+
+func joinQueue(c echo.Context, game *g.Game, req JoinGameRequest) error {
+	playerID := uuid.New().String()
+	player := game.NewPlayer(playerID, req.Username, false)
+	player.Pending = true
+	if err := game.QueuePlayer(player); err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	UpdateSessionWithNewPlayer(c, playerID, req.Username, req.GameID)
+
+	return c.JSON(http.StatusOK, map[string]any{
+		"gameID":   req.GameID,
+		"playerID": playerID,
+		"queued":   true,
+	})
+}
+
+// ListGamesHandler returns a page of public games for the lobby browser
+func ListGamesHandler(c echo.Context, gameServer *server.GameServer) error {
+	var filter server.GameFilter
+	err := echo.QueryParamsBinder(c).
+		String("language", &filter.Language).
+		String("search", &filter.Search).
+		Bool("includeFull", &filter.IncludeFull).
+		Bool("includeInProgress", &filter.IncludeInProgress).
+		Int("page", &filter.Page).
+		Int("pageSize", &filter.PageSize).
+		BindError()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": "Invalid query parameters"})
+	}
+
+	games, total := gameServer.ListGames(filter)
+	return c.JSON(http.StatusOK, map[string]any{
+		"games": games,
+		"total": total,
+	})
+}
+
+// ListWordCategoriesHandler returns the categories hosts can restrict words to
+func ListWordCategoriesHandler(c echo.Context) error {
+	categories, err := db.GetWordCategories()
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": "Failed to load categories"})
+	}
+	return c.JSON(http.StatusOK, categories)
+}
@@ -21,6 +21,22 @@ func RegisterRoutes(e *echo.Echo, server *server.GameServer) {
 		return ServeWs(c, server)
 	})
 
+	e.GET("/games", func(c echo.Context) error {
+		return ListGamesHandler(c, server)
+	})
+
+	e.GET("/words/categories", ListWordCategoriesHandler)
+
+	e.GET("/game/:id/drawings", func(c echo.Context) error {
+		return ListDrawingsHandler(c, server)
+	})
+	e.GET("/game/:id/drawings/:drawingID", func(c echo.Context) error {
+		return GetDrawingHandler(c, server)
+	})
+	e.GET("/game/:id/drawings/:drawingID/svg", func(c echo.Context) error {
+		return GetDrawingSVGHandler(c, server)
+	})
+
 	// userService := user.NewService()
 	// RegisterAuthRoutes(e, userService)
 
@@ -25,13 +25,14 @@ type ErrorResponse struct {
 }
 
 func ServeWs(c echo.Context, server *server.GameServer) error {
-	gameID := c.Param("id")
-	log.Printf("ServeWs: received gameID: %s", gameID)
+	log.Printf("ServeWs: received gameID: %s", c.Param("id"))
 
-	game, exists := server.GetGame(gameID)
+	// The route accepts the room code as well as the ID
+	game, exists := server.GetGame(c.Param("id"))
 	if !exists {
 		return c.JSON(http.StatusNotFound, map[string]string{"error": "Game not found"})
 	}
+	gameID := game.ID
 
 	hub, exists := server.GetHub(gameID)
 	if !exists {
@@ -59,7 +60,10 @@ func ServeWs(c echo.Context, server *server.GameServer) error {
 	}
 
 	// Update the player's connection status
-	player := game.GetPlayerByID(playerID)
+	player := game.GetSpectatorByID(playerID)
+	if player == nil {
+		player = game.GetPlayerByID(playerID)
+	}
 
 	// Handle reconnection if needed
 	if player == nil {
@@ -103,9 +107,13 @@ func ServeWs(c echo.Context, server *server.GameServer) error {
 
 	hub.Broadcast <- b
 
-	// Broadcast game state after a short delay
+	// Broadcast game state after a short delay, then bring this player's
+	// canvas up to date with anything drawn before they connected. Strokes
+	// that reach them live in the meantime are also in the history; its seq
+	// tells the client which live ones to drop.
 	time.AfterFunc(200*time.Millisecond, func() {
 		game.BroadcastGameState()
+		game.SendCanvasHistory(playerID)
 	})
 
 	return nil
@@ -6,5 +6,6 @@ type Messenger interface {
 	BroadcastMessage(message []byte)
 	SendToPlayer(playerID string, message []byte)
 	SendToOthers(playerID string, message []byte)
+	SendToPlayers(playerIDs []string, message []byte)
 	GameEventChannel() <-chan e.GameEvent
 }
@@ -0,0 +1,53 @@
+package server
+
+import (
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	// roomCodeAlphabet leaves out O, 0, I and 1, which are easy to mix up
+	// when a code is read aloud.
+	roomCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+	roomCodeLength   = 6
+	// roomCodeAttempts bounds how many codes CreateGame tries before giving
+	// up; with 32^6 codes a collision is already unlikely.
+	roomCodeAttempts = 10
+)
+
+// newRoomCode returns a random room code.
+func newRoomCode() (string, error) {
+	var b strings.Builder
+	max := big.NewInt(int64(len(roomCodeAlphabet)))
+	for range roomCodeLength {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b.WriteByte(roomCodeAlphabet[n.Int64()])
+	}
+	return b.String(), nil
+}
+
+// uniqueRoomCode returns a code no live game is using. Callers must hold
+// s.mu.
+func (s *GameServer) uniqueRoomCode() (string, error) {
+	for range roomCodeAttempts {
+		code, err := newRoomCode()
+		if err != nil {
+			return "", err
+		}
+		if _, taken := s.codes[code]; !taken {
+			return code, nil
+		}
+	}
+	return "", errors.New("no free room code")
+}
+
+// normalizeRoomCode makes codes typed by hand match: case and surrounding
+// spaces don't matter.
+func normalizeRoomCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestNewRoomCode(t *testing.T) {
+	for range 100 {
+		code, err := newRoomCode()
+		require.NoError(t, err)
+		assert.Len(t, code, roomCodeLength)
+		assert.False(t, strings.ContainsAny(code, "O0I1"), code)
+	}
+}
+
+func TestRoomCodeLookup(t *testing.T) {
+	s := NewGameServer(zap.NewNop())
+	t.Cleanup(s.cancelFunc)
+
+	id := uuid.New().String()
+	require.NoError(t, s.CreateGame(id, shared.GameOptions{}))
+	g, _ := s.GetGame(id)
+	code := g.Code
+	require.Len(t, code, roomCodeLength)
+
+	byCode, exists := s.GetGame(" " + strings.ToLower(code) + " ")
+	require.True(t, exists)
+	assert.Same(t, g, byCode)
+	_, exists = s.GetHub(code)
+	assert.True(t, exists)
+
+	resolved, ok := s.ResolveGameID(code)
+	assert.True(t, ok)
+	assert.Equal(t, id, resolved)
+
+	require.NoError(t, s.StopGame(id))
+	_, exists = s.GetGame(code)
+	assert.False(t, exists, "the code is freed with the game")
+	_, ok = s.ResolveGameID(code)
+	assert.False(t, ok)
+
+	resolved, ok = s.ResolveGameID(id)
+	assert.True(t, ok, "finished games are still found by ID")
+	assert.Equal(t, id, resolved)
+}
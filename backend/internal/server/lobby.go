@@ -0,0 +1,75 @@
+package server
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/Ajstraight619/pictionary-server/internal/game"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+// GameFilter narrows the public game list. Full and running games are left
+// out unless asked for, since nobody can take a seat in them.
+type GameFilter struct {
+	Language          string // Only games in this language
+	Search            string // Only games whose host name contains this, ignoring case
+	IncludeFull       bool
+	IncludeInProgress bool // Also list games that are running or finished
+	Page              int  // 1-based
+	PageSize          int
+}
+
+// ListGames returns one page of public games matching filter, newest
+// first, along with how many games match in total.
+func (s *GameServer) ListGames(filter GameFilter) ([]game.GameListing, int) {
+	s.mu.RLock()
+	instances := make([]*GameInstance, 0, len(s.games))
+	for _, instance := range s.games {
+		instances = append(instances, instance)
+	}
+	s.mu.RUnlock()
+
+	slices.SortFunc(instances, func(a, b *GameInstance) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Game.ID, b.Game.ID)
+	})
+
+	language := strings.ToLower(strings.TrimSpace(filter.Language))
+	search := strings.ToLower(strings.TrimSpace(filter.Search))
+	listings := []game.GameListing{}
+	for _, instance := range instances {
+		listing := instance.Game.Listing()
+		switch {
+		case !listing.IsPublic:
+		case listing.Full() && !filter.IncludeFull:
+		case listing.Status != game.NotStarted && !filter.IncludeInProgress:
+		case language != "" && listing.Language != language:
+		case search != "" && !strings.Contains(strings.ToLower(listing.HostName), search):
+		default:
+			listings = append(listings, listing)
+		}
+	}
+
+	pageSize := filter.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	pageSize = min(pageSize, maxPageSize)
+	page := max(filter.Page, 1)
+
+	total := len(listings)
+	// Check the page is in range before multiplying, so a huge page number
+	// can't overflow into a valid offset
+	start := total
+	if page-1 <= total/pageSize {
+		start = min((page-1)*pageSize, total)
+	}
+	end := min(start+pageSize, total)
+	return listings[start:end], total
+}
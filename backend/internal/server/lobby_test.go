@@ -0,0 +1,68 @@
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/Ajstraight619/pictionary-server/internal/game"
+	"github.com/Ajstraight619/pictionary-server/internal/shared"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func addTestGame(t *testing.T, s *GameServer, id, host string, options shared.GameOptions, players int) *game.Game {
+	t.Helper()
+	require.NoError(t, s.CreateGame(id, options))
+	g, _ := s.GetGame(id)
+	for i := range players {
+		g.AddPlayer(g.NewPlayer(fmt.Sprintf("%s-%d", id, i), host, i == 0))
+	}
+	// Keep CreatedAt distinct so the order is predictable
+	s.games[id].CreatedAt = time.Now().Add(time.Duration(len(s.games)) * time.Second)
+	return g
+}
+
+func TestListGames(t *testing.T) {
+	s := NewGameServer(zap.NewNop())
+	t.Cleanup(s.cancelFunc)
+
+	addTestGame(t, s, "open", "Alice", shared.GameOptions{IsPublic: true, MaxPlayers: 4}, 2)
+	addTestGame(t, s, "private", "Bob", shared.GameOptions{MaxPlayers: 4}, 1)
+	addTestGame(t, s, "full", "Carol", shared.GameOptions{IsPublic: true, MaxPlayers: 2}, 2)
+	running := addTestGame(t, s, "running", "Dave", shared.GameOptions{IsPublic: true, Language: "ES"}, 2)
+	running.Mu.Lock()
+	running.Status = game.InProgress
+	running.Mu.Unlock()
+
+	games, total := s.ListGames(GameFilter{})
+	require.Equal(t, 1, total)
+	assert.Equal(t, "open", games[0].ID)
+	assert.Equal(t, "Alice", games[0].HostName)
+	assert.Equal(t, 2, games[0].Players)
+	assert.Equal(t, shared.DefaultLanguage, games[0].Language)
+
+	games, total = s.ListGames(GameFilter{IncludeFull: true, IncludeInProgress: true})
+	assert.Equal(t, 3, total)
+	assert.Equal(t, "running", games[0].ID, "newest first")
+
+	games, _ = s.ListGames(GameFilter{IncludeInProgress: true, Language: "es"})
+	require.Len(t, games, 1)
+	assert.Equal(t, "running", games[0].ID)
+
+	games, _ = s.ListGames(GameFilter{IncludeFull: true, Search: "caro"})
+	require.Len(t, games, 1)
+	assert.Equal(t, "full", games[0].ID)
+
+	games, total = s.ListGames(GameFilter{IncludeFull: true, IncludeInProgress: true, Page: 2, PageSize: 2})
+	assert.Equal(t, 3, total)
+	require.Len(t, games, 1)
+	assert.Equal(t, "open", games[0].ID)
+
+	games, _ = s.ListGames(GameFilter{Page: 5})
+	assert.Empty(t, games)
+
+	games, _ = s.ListGames(GameFilter{Page: 2305843009213693953, PageSize: 8})
+	assert.Empty(t, games, "a page number that overflows the offset")
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/Ajstraight619/pictionary-server/internal/game"
 	"github.com/Ajstraight619/pictionary-server/internal/shared"
 	"github.com/Ajstraight619/pictionary-server/internal/ws"
+	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
 
@@ -17,12 +18,15 @@ type GameInstance struct {
 	Game       *game.Game
 	Hub        *ws.Hub
 	CancelFunc context.CancelFunc
+	CreatedAt  time.Time
+	Code       string
 }
 
 type GameServer struct {
 	ctx        context.Context
 	cancelFunc context.CancelFunc
 	games      map[string]*GameInstance
+	codes      map[string]string // Room code -> game ID, for live games only
 	mu         sync.RWMutex
 	logger     *zap.Logger
 }
@@ -33,6 +37,7 @@ func NewGameServer(logger *zap.Logger) *GameServer {
 		ctx:        ctx,
 		cancelFunc: cancel,
 		games:      make(map[string]*GameInstance),
+		codes:      make(map[string]string),
 		logger:     logger,
 	}
 
@@ -41,26 +46,39 @@ func NewGameServer(logger *zap.Logger) *GameServer {
 	return server
 }
 
-// CreateGame now creates a game-specific context
+// CreateGame now creates a game-specific context. Each game also gets a
+// short room code players can use in place of its ID.
 func (s *GameServer) CreateGame(id string, options shared.GameOptions) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	code, err := s.uniqueRoomCode()
+	if err != nil {
+		return err
+	}
+
 	gameCtx, gameCancel := context.WithCancel(s.ctx)
 
 	// Create hub and game with game-specific context
 	hub := ws.NewHub(gameCtx)
+	gameHistory := game.NewHistoryService()
 	game := game.NewGame(gameCtx, id, options, hub, s)
+	game.Code = code
 	game.InitGameEvents()
 
 	// Set up the disconnect handler
 	hub.OnDisconnect = game.HandleDisconnect
+	hub.IsChatting = game.IsChatting
+	game.History = gameHistory
 
 	s.games[id] = &GameInstance{
 		Game:       game,
 		Hub:        hub,
 		CancelFunc: gameCancel,
+		CreatedAt:  time.Now(),
+		Code:       code,
 	}
+	s.codes[code] = id
 
 	go hub.Run()
 	go game.Run()
@@ -81,8 +99,9 @@ func (s *GameServer) StopGame(id string) error {
 	// Cancel this specific game's context
 	instance.CancelFunc()
 
-	// Remove from games map
+	// Remove from games map and free its code
 	delete(s.games, id)
+	delete(s.codes, instance.Code)
 
 	return nil
 }
@@ -104,30 +123,59 @@ func (s *GameServer) Shutdown(ctx context.Context) error {
 	}
 }
 
-// GetGame returns a specific game
+// GetGame returns a specific game, by ID or room code
 func (s *GameServer) GetGame(id string) (*game.Game, bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	instance, exists := s.games[id]
+	instance, exists := s.lookup(id)
 	if !exists {
 		return nil, false
 	}
 	return instance.Game, true
 }
 
-// GetHub returns a specific hub
+// GetHub returns a specific hub, by game ID or room code
 func (s *GameServer) GetHub(id string) (*ws.Hub, bool) {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
 
-	instance, exists := s.games[id]
+	instance, exists := s.lookup(id)
 	if !exists {
 		return nil, false
 	}
 	return instance.Hub, true
 }
 
+// ResolveGameID turns a live room's code into the ID its current match is
+// recorded under. History IDs, which a room's first match shares with the
+// game's ID and later matches get from gameState and gameEnded, pass through
+// unchanged, so finished matches can still be looked up.
+func (s *GameServer) ResolveGameID(idOrCode string) (string, bool) {
+	if _, err := uuid.Parse(idOrCode); err == nil {
+		return idOrCode, true
+	}
+	s.mu.RLock()
+	instance, exists := s.lookup(idOrCode)
+	s.mu.RUnlock()
+	if exists {
+		return instance.Game.GetHistoryID(), true
+	}
+	return "", false
+}
+
+// lookup finds a live game by ID or room code. Callers must hold s.mu.
+func (s *GameServer) lookup(idOrCode string) (*GameInstance, bool) {
+	if instance, exists := s.games[idOrCode]; exists {
+		return instance, true
+	}
+	if id, exists := s.codes[normalizeRoomCode(idOrCode)]; exists {
+		instance, exists := s.games[id]
+		return instance, exists
+	}
+	return nil, false
+}
+
 func (s *GameServer) OnGameEnded(gameID string) {
 	s.StopGame(gameID)
 }
@@ -183,8 +231,9 @@ func (s *GameServer) cleanupInactiveGames() {
 	for _, id := range gameIDsToRemove {
 		log.Printf("Cleaning up inactive game: %s", id)
 		instance := s.games[id]
-		instance.CancelFunc() // Cancel the game context
-		delete(s.games, id)   // Remove from games map
+		instance.CancelFunc()          // Cancel the game context
+		delete(s.games, id)            // Remove from games map
+		delete(s.codes, instance.Code) // Free the room code for reuse
 	}
 
 	log.Printf("Inactive games cleanup complete. Removed %d games. Current games: %d",
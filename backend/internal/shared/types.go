@@ -6,18 +6,93 @@ import (
 )
 
 type GameOptions struct {
-	TurnTimeLimit       int `json:"turnTimeLimit"`
-	WordSelectTimeLimit int `json:"wordSelectTimeLimit"`
-	RoundLimit          int `json:"roundLimit"`
-	MaxPlayers          int `json:"maxPlayers"`
+	TurnTimeLimit       int      `json:"turnTimeLimit"`
+	WordSelectTimeLimit int      `json:"wordSelectTimeLimit"`
+	RoundLimit          int      `json:"roundLimit"`
+	MaxPlayers          int      `json:"maxPlayers"`
+	TeamMode            bool     `json:"teamMode"`
+	TeamCount           int      `json:"teamCount"`
+	TeamPlay            string   `json:"teamPlay"` // TeamPlayTeammates (the default) or TeamPlayRace
+	CustomWords         []string `json:"customWords"`
+	CustomWordsOnly     bool     `json:"customWordsOnly"`     // Replace the default words instead of mixing with them
+	Categories          []string `json:"categories"`          // Default-word categories to draw from; empty means all
+	Scoring             string   `json:"scoring"`             // ScoringLinear, ScoringPlacement or ScoringDrawerShare
+	Hints               string   `json:"hints"`               // One of the Hints* strategies; empty means HintsLinear
+	HintTimes           []int    `json:"hintTimes"`           // Seconds remaining at which HintsFixed reveals a letter
+	ShowCategory        bool     `json:"showCategory"`        // Tell guessers the word's category when the turn starts
+	ShowWordLength      bool     `json:"showWordLength"`      // Tell guessers the word's letter counts when the turn starts
+	ChatFilter          string   `json:"chatFilter"`          // ChatFilterMask, ChatFilterBlock or ChatFilterOff; empty means ChatFilterMask
+	BlockedWords        []string `json:"blockedWords"`        // Extra words the chat filter catches
+	VoteKickMajority    float64  `json:"voteKickMajority"`    // Share of connected players needed to kick; 0 means more than half
+	VoteKickTime        int      `json:"voteKickTime"`        // Seconds a kick vote stays open; 0 means 30
+	VoteKickHostVeto    bool     `json:"voteKickHostVeto"`    // Let the host cancel a kick vote by voting no
+	DrawerReconnectWait int      `json:"drawerReconnectWait"` // Seconds to wait for a disconnected drawer before skipping their turn; 0 means 5
+	TurnSummaryTime     int      `json:"turnSummaryTime"`     // Seconds the word and scores are shown after each turn; 0 means 5
+	RoundSummaryTime    int      `json:"roundSummaryTime"`    // Seconds the standings are shown between rounds; 0 means 8
+	IsPublic            bool     `json:"isPublic"`            // List the game in the public lobby browser
+	Language            string   `json:"language"`            // Language code the room plays in; empty means DefaultLanguage
 }
 
+// DefaultLanguage is the language of games that don't set one.
+const DefaultLanguage = "en"
+
+const (
+	// TeamPlayTeammates only lets the drawer's teammates guess.
+	TeamPlayTeammates = "teammates"
+	// TeamPlayRace lets every team guess; points go to the guesser's team.
+	TeamPlayRace = "race"
+)
+
+const (
+	// ScoringLinear scores guesses by time remaining; the drawer earns a
+	// bonus only if everyone guesses.
+	ScoringLinear = "linear"
+	// ScoringPlacement scores guesses by the order they came in.
+	ScoringPlacement = "placement"
+	// ScoringDrawerShare scores guesses by time remaining and credits the
+	// drawer for each player who guesses.
+	ScoringDrawerShare = "drawerShare"
+)
+
+const (
+	// HintsNone never reveals letters.
+	HintsNone = "none"
+	// HintsLinear reveals random letters at a steady pace.
+	HintsLinear = "linear"
+	// HintsVowelsFirst reveals vowels before consonants at a steady pace.
+	HintsVowelsFirst = "vowelsFirst"
+	// HintsFirstLast reveals the first and last letter of each word.
+	HintsFirstLast = "firstLast"
+	// HintsFixed reveals one random letter at each of HintTimes.
+	HintsFixed = "fixed"
+)
+
+const (
+	// ChatFilterMask replaces profanity with asterisks.
+	ChatFilterMask = "mask"
+	// ChatFilterBlock drops messages containing profanity.
+	ChatFilterBlock = "block"
+	// ChatFilterOff lets everything through.
+	ChatFilterOff = "off"
+)
+
 type Word struct {
-	Id       uint   `gorm:"primaryKey" json:"id"`
-	Word     string `gorm:"not null" json:"word"`
-	Category string `gorm:"not null" json:"category"`
+	Id         uint     `gorm:"primaryKey" json:"id"`
+	Word       string   `gorm:"not null" json:"word"`
+	Category   string   `gorm:"not null" json:"category"`
+	Difficulty string   `gorm:"not null;default:'medium'" json:"difficulty"`
+	Alternates []string `gorm:"type:jsonb;serializer:json" json:"alternates,omitempty"` // Other answers accepted as correct
 }
 
+const (
+	DifficultyEasy   = "easy"
+	DifficultyMedium = "medium"
+	DifficultyHard   = "hard"
+)
+
+// Difficulties lists the word tiers from easiest to hardest.
+var Difficulties = []string{DifficultyEasy, DifficultyMedium, DifficultyHard}
+
 type ClientInterface interface {
 	SendMessage([]byte) error
 	Close() error
@@ -38,6 +113,9 @@ type Player struct {
 	IsGuessCorrect bool            `json:"isGuessCorrect"`
 	Pending        bool            `json:"pending"`
 	Connected      bool            `json:"connected"`
+	IsSpectator    bool            `json:"isSpectator"`
+	Queued         bool            `json:"queued"`
+	Team           int             `json:"team,omitempty"`
 	Avatar         string          `json:"avatar"`
 	Client         ClientInterface `json:"-"`
 }
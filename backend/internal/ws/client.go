@@ -7,6 +7,7 @@ import (
 	"time"
 
 	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/Ajstraight619/pictionary-server/internal/utils"
 	"github.com/gorilla/websocket"
 )
 
@@ -33,6 +34,7 @@ type Client struct {
 	Send     chan []byte
 	Conn     *websocket.Conn
 	PlayerID string
+	limiter  *rateLimiter
 	ctx      context.Context
 	cancel   context.CancelFunc
 }
@@ -44,6 +46,7 @@ func NewClient(hub *Hub, conn *websocket.Conn, playerID string) *Client {
 		Send:     make(chan []byte, 256),
 		Conn:     conn,
 		PlayerID: playerID,
+		limiter:  newRateLimiter(hub.RateLimits, time.Now()),
 		ctx:      ctx,
 		cancel:   cancel,
 	}
@@ -72,6 +75,21 @@ func (c *Client) Read() {
 		e.PlayerReady:       true,
 		e.PlayerToggleReady: true,
 		e.RemovePlayer:      true,
+		e.CursorUpdate:      true,
+		e.DrawStroke:        true,
+		e.DrawShape:         true,
+		e.FillArea:          true,
+		e.ClearCanvas:       true,
+		e.UndoStroke:        true,
+		e.RedoStroke:        true,
+		e.SwitchTeam:        true,
+		e.UpdateOptions:     true,
+		e.VoteKick:          true,
+		e.TransferHost:      true,
+		e.PauseGame:         true,
+		e.ResumeGame:        true,
+		e.Rematch:           true,
+		e.DeclineRematch:    true,
 	}
 
 	for {
@@ -82,29 +100,62 @@ func (c *Client) Read() {
 		}
 
 		var gameEvent e.GameEvent
-		// Try to parse the message as a GameEvent.
-		if err := json.Unmarshal(message, &gameEvent); err == nil && gameEvent.Type != "" {
-			// Only handle if it's a recognized event
-			if recognizedEvents[gameEvent.Type] {
-				select {
-				case <-c.ctx.Done():
-					log.Printf("Client.Read: context cancelled for player %s", c.PlayerID)
-					return
-				case c.Hub.GameEvents <- gameEvent:
-					log.Printf("Client.Read: Dispatched game event %s for player %s", gameEvent.Type, c.PlayerID)
-					continue // Skip broadcasting; it is handled internally.
-				default:
-					log.Printf("Client.Read: GameEvents channel full, discarding event for player %s", c.PlayerID)
-				}
+		if err := json.Unmarshal(message, &gameEvent); err != nil || !recognizedEvents[gameEvent.Type] {
+			// Everything a client sends must go through the game; nothing is relayed as-is.
+			log.Printf("Client.Read: dropping unrecognized message from player %s", c.PlayerID)
+			continue
+		}
+		gameEvent.PlayerID = c.PlayerID
+
+		if !c.allow(gameEvent.Type) {
+			if c.ctx.Err() != nil {
+				return
 			}
+			continue
 		}
 
-		// ! Broadcast messages to all clients that do not change the internal game state.
-		c.Hub.Broadcast <- message
+		select {
+		case <-c.ctx.Done():
+			log.Printf("Client.Read: context cancelled for player %s", c.PlayerID)
+			return
+		case c.Hub.GameEvents <- gameEvent:
+			log.Printf("Client.Read: Dispatched game event %s for player %s", gameEvent.Type, c.PlayerID)
+		default:
+			log.Printf("Client.Read: GameEvents channel full, discarding event for player %s", c.PlayerID)
+		}
 	}
 
 }
 
+// allow applies the client's rate limits to an event. Throttled clients get
+// an errorNotification, and clients that keep flooding are disconnected.
+func (c *Client) allow(eventType string) bool {
+	chatting := eventType == e.PlayerGuess && c.Hub.IsChatting != nil && c.Hub.IsChatting(c.PlayerID)
+	now := time.Now()
+	if c.limiter.allow(eventClass(eventType, chatting), now) {
+		return true
+	}
+
+	notify, disconnect := c.limiter.violate(now)
+	if disconnect {
+		log.Printf("Client.Read: disconnecting player %s for flooding", c.PlayerID)
+		c.Conn.WriteControl(websocket.CloseMessage,
+			websocket.FormatCloseMessage(websocket.ClosePolicyViolation, "rate limit exceeded"),
+			time.Now().Add(writeWait))
+		c.cancel()
+		return false
+	}
+	if notify {
+		log.Printf("Client.Read: throttling %s events from player %s", eventType, c.PlayerID)
+		payload := e.ErrorNotificationPayload{Message: "You're sending messages too fast. Slow down!", Code: 429}
+		if b, err := utils.CreateMessage(string(e.EvtErrorNotification), payload); err == nil {
+			// The hub owns c.Send and knows whether it has been closed
+			c.Hub.sendTo(func(client *Client) bool { return client == c }, b)
+		}
+	}
+	return false
+}
+
 func (c *Client) Write() {
 	ticker := time.NewTicker(pingPeriod)
 	defer func() {
@@ -8,14 +8,29 @@ import (
 	e "github.com/Ajstraight619/pictionary-server/internal/events"
 )
 
+// gameEventBuffer is sized so a drawer's stroke stream doesn't crowd out
+// guesses and control events while the game loop catches up.
+const gameEventBuffer = 256
+
+// directMessage is a message for only the clients that match to.
+type directMessage struct {
+	to      func(*Client) bool
+	message []byte
+}
+
 type Hub struct {
 	ctx          context.Context
 	Broadcast    chan []byte
+	direct       chan directMessage
 	GameEvents   chan e.GameEvent
 	Clients      map[*Client]bool
 	Register     chan *Client
 	Unregister   chan *Client
 	OnDisconnect func(playerID string)
+	// IsChatting reports whether a player's playerGuess messages are chat
+	// rather than guesses, for rate limiting.
+	IsChatting func(playerID string) bool
+	RateLimits RateLimits
 }
 
 type Hubs struct {
@@ -27,10 +42,12 @@ func NewHub(ctx context.Context) *Hub {
 	return &Hub{
 		ctx:        ctx,
 		Broadcast:  make(chan []byte),
-		GameEvents: make(chan e.GameEvent, 10),
+		direct:     make(chan directMessage),
+		GameEvents: make(chan e.GameEvent, gameEventBuffer),
 		Clients:    make(map[*Client]bool),
 		Register:   make(chan *Client),
 		Unregister: make(chan *Client),
+		RateLimits: RateLimitsFromEnv(),
 	}
 }
 
@@ -68,19 +85,16 @@ func (h *Hub) Run() {
 			h.Clients[client] = true
 		case client := <-h.Unregister:
 			if _, ok := h.Clients[client]; ok {
-				delete(h.Clients, client)
-				close(client.Send)
-				if h.OnDisconnect != nil {
-					go h.OnDisconnect(client.PlayerID)
-				}
+				h.drop(client)
 			}
 		case message := <-h.Broadcast:
 			for client := range h.Clients {
-				select {
-				case client.Send <- message:
-				default:
-					close(client.Send)
-					delete(h.Clients, client)
+				h.deliver(client, message)
+			}
+		case dm := <-h.direct:
+			for client := range h.Clients {
+				if dm.to(client) {
+					h.deliver(client, dm.message)
 				}
 			}
 		case <-h.ctx.Done():
@@ -90,25 +104,55 @@ func (h *Hub) Run() {
 	}
 }
 
+// deliver queues message for client without blocking. A client too far
+// behind to take it is dropped, so one slow connection can't stall the
+// game. Only Run may call it, since Run owns Clients.
+func (h *Hub) deliver(client *Client, message []byte) {
+	select {
+	case client.Send <- message:
+	default:
+		h.drop(client)
+	}
+}
+
+// drop removes client and tells the game it has gone, as if it had
+// disconnected. Only Run may call it.
+func (h *Hub) drop(client *Client) {
+	delete(h.Clients, client)
+	close(client.Send)
+	if h.OnDisconnect != nil {
+		go h.OnDisconnect(client.PlayerID)
+	}
+}
+
 func (h *Hub) BroadcastMessage(message []byte) {
 	h.Broadcast <- message
 }
 
-func (h *Hub) SendToOthers(playerID string, message []byte) {
-	for client := range h.Clients {
-		if client.PlayerID != playerID {
-			client.Send <- message
-		}
+// sendTo hands a message for the clients matching to over to Run, which
+// owns the client list.
+func (h *Hub) sendTo(to func(*Client) bool, message []byte) {
+	select {
+	case h.direct <- directMessage{to: to, message: message}:
+	case <-h.ctx.Done():
 	}
 }
 
+func (h *Hub) SendToOthers(playerID string, message []byte) {
+	h.sendTo(func(client *Client) bool { return client.PlayerID != playerID }, message)
+}
+
 func (h *Hub) SendToPlayer(playerID string, message []byte) {
-	for client := range h.Clients {
-		if client.PlayerID == playerID {
-			client.Send <- message
-			break
-		}
+	h.sendTo(func(client *Client) bool { return client.PlayerID == playerID }, message)
+}
+
+// SendToPlayers sends message only to the listed players.
+func (h *Hub) SendToPlayers(playerIDs []string, message []byte) {
+	recipients := make(map[string]bool, len(playerIDs))
+	for _, id := range playerIDs {
+		recipients[id] = true
 	}
+	h.sendTo(func(client *Client) bool { return recipients[client.PlayerID] }, message)
 }
 
 func (h *Hub) GameEventChannel() <-chan e.GameEvent {
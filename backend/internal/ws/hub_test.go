@@ -0,0 +1,41 @@
+package ws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHubDirectSends(t *testing.T) {
+	hub := NewHub(context.Background())
+	disconnected := make(chan string, 1)
+	hub.OnDisconnect = func(playerID string) { disconnected <- playerID }
+	go hub.Run()
+
+	alice := &Client{Hub: hub, Send: make(chan []byte, 1), PlayerID: "alice"}
+	bob := &Client{Hub: hub, Send: make(chan []byte, 1), PlayerID: "bob"}
+	hub.Register <- alice
+	hub.Register <- bob
+
+	hub.SendToPlayer("alice", []byte("hi alice"))
+	assert.Equal(t, "hi alice", string(<-alice.Send))
+
+	hub.SendToOthers("alice", []byte("hi others"))
+	assert.Equal(t, "hi others", string(<-bob.Send))
+
+	hub.SendToPlayers([]string{"bob"}, []byte("hi bob"))
+	assert.Equal(t, "hi bob", string(<-bob.Send))
+	assert.Empty(t, alice.Send)
+
+	// Alice's buffer fills up; the next message drops her instead of
+	// blocking the hub.
+	hub.SendToPlayer("alice", []byte("one"))
+	hub.SendToPlayer("alice", []byte("two"))
+	hub.SendToPlayer("bob", []byte("still here"))
+	assert.Equal(t, "still here", string(<-bob.Send))
+	assert.Equal(t, "one", string(<-alice.Send))
+	_, open := <-alice.Send
+	assert.False(t, open, "a client that can't keep up is dropped")
+	assert.Equal(t, "alice", <-disconnected, "and the game hears they've gone")
+}
@@ -0,0 +1,143 @@
+package ws
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+)
+
+// RateClass groups client events that share a rate limit.
+type RateClass string
+
+const (
+	RateClassGuess   RateClass = "guess"   // Guesses
+	RateClassChat    RateClass = "chat"    // Chat from the drawer and players who already guessed
+	RateClassStroke  RateClass = "stroke"  // Canvas drawing and cursor updates
+	RateClassControl RateClass = "control" // Everything else: ready, timers, options...
+)
+
+// RateLimit is a token bucket: Burst messages at once, refilled at Rate per
+// second.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+type RateLimits map[RateClass]RateLimit
+
+const (
+	// maxViolations throttled messages within violationWindow get a client
+	// disconnected.
+	maxViolations   = 50
+	violationWindow = 10 * time.Second
+
+	// throttleNoticeInterval keeps a throttled client from being flooded with
+	// errors of its own.
+	throttleNoticeInterval = time.Second
+)
+
+// DefaultRateLimits leaves room for fast typing and smooth drawing while
+// stopping a single tab from starving the room.
+func DefaultRateLimits() RateLimits {
+	return RateLimits{
+		RateClassGuess:   {Rate: 3, Burst: 6},
+		RateClassChat:    {Rate: 2, Burst: 5},
+		RateClassStroke:  {Rate: 60, Burst: 120},
+		RateClassControl: {Rate: 2, Burst: 10},
+	}
+}
+
+// RateLimitsFromEnv returns the default limits, overridden by
+// WS_RATE_<CLASS>=rate,burst variables such as WS_RATE_STROKE=30,60.
+func RateLimitsFromEnv() RateLimits {
+	limits := DefaultRateLimits()
+	for class := range limits {
+		value := os.Getenv("WS_RATE_" + strings.ToUpper(string(class)))
+		if value == "" {
+			continue
+		}
+		var limit RateLimit
+		if _, err := fmt.Sscanf(value, "%g,%d", &limit.Rate, &limit.Burst); err != nil || limit.Rate <= 0 || limit.Burst <= 0 {
+			log.Printf("RateLimitsFromEnv: ignoring invalid WS_RATE_%s=%q", strings.ToUpper(string(class)), value)
+			continue
+		}
+		limits[class] = limit
+	}
+	return limits
+}
+
+// eventClass returns the rate class for a client event. Guesses and chat
+// share the playerGuess event, so chatting says which one the sender is
+// doing.
+func eventClass(eventType string, chatting bool) RateClass {
+	switch eventType {
+	case e.PlayerGuess:
+		if chatting {
+			return RateClassChat
+		}
+		return RateClassGuess
+	case e.DrawStroke, e.DrawShape, e.FillArea, e.ClearCanvas, e.UndoStroke, e.RedoStroke, e.CursorUpdate:
+		return RateClassStroke
+	default:
+		return RateClassControl
+	}
+}
+
+type tokenBucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+func (b *tokenBucket) allow(now time.Time) bool {
+	b.tokens = min(float64(b.limit.Burst), b.tokens+now.Sub(b.last).Seconds()*b.limit.Rate)
+	b.last = now
+	if b.tokens < 1 {
+		return false
+	}
+	b.tokens--
+	return true
+}
+
+// rateLimiter tracks one client's buckets and violations. It's only used
+// from the client's read loop, so it needs no locking.
+type rateLimiter struct {
+	buckets     map[RateClass]*tokenBucket
+	violations  int
+	windowStart time.Time
+	lastNotice  time.Time
+}
+
+func newRateLimiter(limits RateLimits, now time.Time) *rateLimiter {
+	r := &rateLimiter{buckets: make(map[RateClass]*tokenBucket, len(limits))}
+	for class, limit := range limits {
+		r.buckets[class] = &tokenBucket{limit: limit, tokens: float64(limit.Burst), last: now}
+	}
+	return r
+}
+
+// allow reports whether a message of the given class may go through.
+// Classes without a limit are never throttled.
+func (r *rateLimiter) allow(class RateClass, now time.Time) bool {
+	bucket, ok := r.buckets[class]
+	return !ok || bucket.allow(now)
+}
+
+// violate records a throttled message. It reports whether the client should
+// be told, and whether it has crossed the line and should be disconnected.
+func (r *rateLimiter) violate(now time.Time) (notify, disconnect bool) {
+	if now.Sub(r.windowStart) > violationWindow {
+		r.windowStart = now
+		r.violations = 0
+	}
+	r.violations++
+	if now.Sub(r.lastNotice) >= throttleNoticeInterval {
+		r.lastNotice = now
+		notify = true
+	}
+	return notify, r.violations >= maxViolations
+}
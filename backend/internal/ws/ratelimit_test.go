@@ -0,0 +1,61 @@
+package ws
+
+import (
+	"testing"
+	"time"
+
+	e "github.com/Ajstraight619/pictionary-server/internal/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterBuckets(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(RateLimits{RateClassGuess: {Rate: 2, Burst: 3}}, now)
+
+	for range 3 {
+		assert.True(t, limiter.allow(RateClassGuess, now))
+	}
+	assert.False(t, limiter.allow(RateClassGuess, now), "burst used up")
+	assert.True(t, limiter.allow(RateClassStroke, now), "classes without a limit pass")
+
+	now = now.Add(500 * time.Millisecond)
+	assert.True(t, limiter.allow(RateClassGuess, now), "refilled one token")
+	assert.False(t, limiter.allow(RateClassGuess, now))
+}
+
+func TestRateLimiterViolations(t *testing.T) {
+	now := time.Now()
+	limiter := newRateLimiter(DefaultRateLimits(), now)
+
+	notify, disconnect := limiter.violate(now)
+	assert.True(t, notify)
+	assert.False(t, disconnect)
+
+	notify, _ = limiter.violate(now)
+	assert.False(t, notify, "one notice per interval")
+
+	for i := 2; i < maxViolations-1; i++ {
+		_, disconnect = limiter.violate(now)
+		assert.False(t, disconnect)
+	}
+	_, disconnect = limiter.violate(now)
+	assert.True(t, disconnect)
+
+	_, disconnect = limiter.violate(now.Add(violationWindow + time.Second))
+	assert.False(t, disconnect, "violations reset after the window")
+}
+
+func TestEventClass(t *testing.T) {
+	assert.Equal(t, RateClassGuess, eventClass(e.PlayerGuess, false))
+	assert.Equal(t, RateClassChat, eventClass(e.PlayerGuess, true))
+	assert.Equal(t, RateClassStroke, eventClass(e.DrawStroke, false))
+	assert.Equal(t, RateClassControl, eventClass(e.PlayerToggleReady, false))
+}
+
+func TestRateLimitsFromEnv(t *testing.T) {
+	t.Setenv("WS_RATE_STROKE", "30,45")
+	t.Setenv("WS_RATE_CHAT", "nonsense")
+	limits := RateLimitsFromEnv()
+	assert.Equal(t, RateLimit{Rate: 30, Burst: 45}, limits[RateClassStroke])
+	assert.Equal(t, DefaultRateLimits()[RateClassChat], limits[RateClassChat])
+}
@@ -38,13 +38,41 @@ func main() {
 		log.Fatalf("Failed to parse JSON file: %v", err)
 	}
 
+	// Read hand-picked difficulty tiers; unlisted words start as medium
+	difficulties := make(map[string]string)
+	if data, err := os.ReadFile("internal/db/word_difficulties.json"); err == nil {
+		var tiers map[string][]string
+		if err := json.Unmarshal(data, &tiers); err != nil {
+			log.Fatalf("Failed to parse difficulty file: %v", err)
+		}
+		for tier, tierWords := range tiers {
+			for _, word := range tierWords {
+				difficulties[word] = tier
+			}
+		}
+	}
+
+	// Read accepted alternate answers, e.g. "Hippopotamus" for "Hippo"
+	alternates := make(map[string][]string)
+	if data, err := os.ReadFile("internal/db/word_alternates.json"); err == nil {
+		if err := json.Unmarshal(data, &alternates); err != nil {
+			log.Fatalf("Failed to parse alternates file: %v", err)
+		}
+	}
+
 	// Prepare the words to insert
 	var words []shared.Word
 	for category, wordsList := range wordsMap {
 		for _, word := range wordsList {
+			difficulty, ok := difficulties[word]
+			if !ok {
+				difficulty = shared.DifficultyMedium
+			}
 			words = append(words, shared.Word{
-				Word:     word,
-				Category: category,
+				Word:       word,
+				Category:   category,
+				Difficulty: difficulty,
+				Alternates: alternates[word],
 			})
 		}
 	}